@@ -5,5 +5,17 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder          = closeVCRRecorder
+	NewVCRScrubber            = newVCRScrubber
+	NormalizeVCRRequestBody   = normalizeVCRRequestBody
+	NormalizeVCRURL           = normalizeVCRURL
+	VCRPlaceholderCredentials = vcrPlaceholderCredentials
 )
+
+func (s *vcrScrubber) AddAccountID(accountID string) {
+	s.addAccountID(accountID)
+}
+
+func (s *vcrScrubber) ScrubString(v string) string {
+	return s.scrubString(v)
+}
@@ -7,27 +7,24 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
@@ -37,6 +34,9 @@ import (
 const (
 	envVarVCRMode = "VCR_MODE"
 	envVarVCRPath = "VCR_PATH"
+
+	// vcrPreCheckStubName is the name of the stub used when configuring the shared test provider in PreCheck.
+	vcrPreCheckStubName = "PreCheck"
 )
 
 type randomnessSource struct {
@@ -107,6 +107,51 @@ func vcrMode() (recorder.Mode, error) {
 	}
 }
 
+// vcrPreCheck prepares the shared test provider to be configured by PreCheck without an AWS account when replaying.
+// The provider's AWS API calls are sent to the local stub server.
+func vcrPreCheck(ctx context.Context) error {
+	if !isVCREnabled() {
+		return nil
+	}
+
+	if mode, err := vcrMode(); err != nil || mode != recorder.ModeReplayOnly {
+		return err
+	}
+
+	transport, err := vcrStubTransport(vcrPreCheckStubName, cleanhttp.DefaultPooledTransport())
+
+	if err != nil {
+		return err
+	}
+
+	if v, ok := Provider.Meta().(*conns.AWSClient); ok {
+		v.SetHTTPClient(ctx, &http.Client{Transport: transport})
+	}
+
+	return nil
+}
+
+// vcrPlaceholderCredentials returns provider configuration with placeholder credentials when replaying without any credentials set,
+// so that tests can be replayed without an AWS account. Otherwise it returns nil.
+func vcrPlaceholderCredentials() map[string]any {
+	if !isVCREnabled() {
+		return nil
+	}
+
+	if mode, err := vcrMode(); err != nil || mode != recorder.ModeReplayOnly {
+		return nil
+	}
+
+	if os.Getenv(envvar.Profile) != "" || os.Getenv(envvar.AccessKeyId) != "" || os.Getenv(envvar.ContainerCredentialsFullURI) != "" {
+		return nil
+	}
+
+	return map[string]any{
+		"access_key": vcrScrubbedAccessKeyID,
+		"secret_key": vcrScrubbedSecret,
+	}
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
@@ -163,6 +208,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		tlsConfig.MinVersion = tls.VersionTLS12
 
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		scrubber := newVCRScrubber()
 
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.NewWithOptions(&recorder.Options{
@@ -171,94 +217,52 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			RealTransport: httpClient.Transport,
 		})
 
+		// When replaying, a test without a cassette uses only the local stub server.
+		if vcrMode == recorder.ModeReplayOnly && errors.Is(err, cassette.ErrCassetteNotFound) {
+			r, err = nil, nil
+		}
+
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		if r != nil {
+			// Remove sensitive HTTP headers.
+			r.AddHook(func(i *cassette.Interaction) error {
+				delete(i.Request.Headers, "Authorization")
+				delete(i.Request.Headers, "X-Amz-Security-Token")
 
-			return nil
-		}, recorder.AfterCaptureHook)
+				return nil
+			}, recorder.AfterCaptureHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
+			// Remove credentials and account IDs before the cassette is saved.
+			// Live responses are not scrubbed as the provider needs the real values while recording.
+			r.AddHook(scrubber.scrub, recorder.BeforeSaveHook)
 
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
+			// Defines how VCR will match requests to responses.
+			r.SetMatcher(vcrRequestMatcher(ctx))
+		}
 
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		var transport http.RoundTripper = r
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		// When replaying, requests that don't match a recorded interaction are sent to the local stub server.
+		if vcrMode == recorder.ModeReplayOnly {
+			fallback, err := vcrStubTransport(testName, httpClient.Transport)
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
 
-			return false
-		})
+			transport = &vcrReplayTransport{
+				recorder: r,
+				fallback: fallback,
+			}
+		}
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient.Transport = transport
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -267,6 +271,16 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		meta.SetHTTPClient(ctx, httpClient)
 		provider.SetMeta(meta)
 
+		for k, v := range vcrPlaceholderCredentials() {
+			if d.Get(k).(string) != "" {
+				continue
+			}
+
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
 			return nil, append(diags, ds...)
 		} else {
@@ -284,6 +298,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// 	}
 		// })
 
+		scrubber.addAccountID(meta.AccountID)
+
 		providerMetas[testName] = meta
 
 		return meta, diags
@@ -409,6 +425,16 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 		delete(providerMetas, testName)
 	}
 
+	// Stop the local stub server.
+	vcrStubs.Lock()
+	stub, ok := vcrStubs[testName]
+	delete(vcrStubs, testName)
+	vcrStubs.Unlock()
+
+	if ok {
+		stub.close()
+	}
+
 	// Save the randomness seed.
 	randomnessSources.Lock()
 	s, ok := randomnessSources[testName]
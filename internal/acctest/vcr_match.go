@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// vcrNondeterministicValue replaces the values of nondeterministic request fields before comparison.
+const vcrNondeterministicValue = "<nondeterministic>"
+
+// vcrNondeterministicFields are the (case-insensitive) names of request fields whose values are generated afresh
+// for every request, for example idempotency tokens generated by the AWS SDKs.
+var vcrNondeterministicFields = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+	"RequestToken",
+}
+
+func isVCRNondeterministicField(name string) bool {
+	return slices.ContainsFunc(vcrNondeterministicFields, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrRequestMatcher returns a cassette.MatcherFunc that matches requests to recorded interactions.
+// Methods must be equal, URLs must be equal ignoring query parameter order and bodies must be equal after
+// normalization for the request's protocol. Nondeterministic fields are ignored.
+func vcrRequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if got, want := normalizeVCRURL(r.URL.String()), normalizeVCRURL(i.URL); got != want {
+			return false
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return i.Body == ""
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		contentType := r.Header.Get("Content-Type")
+
+		got, err := normalizeVCRRequestBody(contentType, body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to normalize request body", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		want, err := normalizeVCRRequestBody(contentType, i.Body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to normalize cassette body", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		return got == want
+	}
+}
+
+// normalizeVCRURL returns the URL with its query parameters sorted and nondeterministic values replaced.
+func normalizeVCRURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+
+	if u.RawQuery != "" {
+		u.RawQuery = normalizeVCRQuery(u.Query())
+	}
+
+	return u.String()
+}
+
+// normalizeVCRRequestBody returns the canonical form of a request body for the specified content type.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func normalizeVCRRequestBody(contentType, body string) (string, error) {
+	if body == "" {
+		return "", nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return normalizeVCRJSON(body)
+	case "application/xml", "text/xml":
+		return normalizeVCRXML(body)
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return "", err
+		}
+
+		return normalizeVCRQuery(values), nil
+	default:
+		return body, nil
+	}
+}
+
+// normalizeVCRJSON returns the JSON document with object keys sorted and nondeterministic values replaced.
+func normalizeVCRJSON(body string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(replaceVCRNondeterministicJSON(v))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func replaceVCRNondeterministicJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isVCRNondeterministicField(k) {
+				v[k] = vcrNondeterministicValue
+			} else {
+				v[k] = replaceVCRNondeterministicJSON(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = replaceVCRNondeterministicJSON(e)
+		}
+	}
+
+	return v
+}
+
+// normalizeVCRQuery returns the encoded query parameters sorted by key with nondeterministic values replaced.
+// The Query protocol flattens nested fields into keys such as "Tags.member.1.Key".
+func normalizeVCRQuery(values url.Values) string {
+	for k := range values {
+		name := k
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			name = k[i+1:]
+		}
+
+		if isVCRNondeterministicField(name) {
+			values[k] = []string{vcrNondeterministicValue}
+		}
+	}
+
+	return values.Encode()
+}
+
+// normalizeVCRXML returns the XML document with insignificant whitespace removed, attributes sorted
+// and nondeterministic element values replaced. Element order is significant.
+func normalizeVCRXML(body string) (string, error) {
+	dec := xml.NewDecoder(strings.NewReader(body))
+
+	var sb strings.Builder
+	var stack []string
+
+	for {
+		token, err := dec.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			attrs := make([]string, 0, len(token.Attr))
+			for _, v := range token.Attr {
+				attrs = append(attrs, fmt.Sprintf("%s:%s=%q", v.Name.Space, v.Name.Local, v.Value))
+			}
+			slices.Sort(attrs)
+
+			fmt.Fprintf(&sb, "<%s:%s %s>", token.Name.Space, token.Name.Local, strings.Join(attrs, " "))
+			stack = append(stack, token.Name.Local)
+		case xml.EndElement:
+			fmt.Fprintf(&sb, "</%s:%s>", token.Name.Space, token.Name.Local)
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			text := strings.TrimSpace(string(token))
+			if text == "" {
+				continue
+			}
+
+			if len(stack) > 0 && isVCRNondeterministicField(stack[len(stack)-1]) {
+				text = vcrNondeterministicValue
+			}
+
+			if err := xml.EscapeText(&sb, []byte(text)); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	envVarVCRStubURL = "VCR_STUB_URL"
+)
+
+// VCRStubRule is a canned response returned by the local stub server for requests that
+// don't match any recorded interaction when replaying.
+// Empty match fields match any request.
+type VCRStubRule struct {
+	// Method is the HTTP method.
+	Method string `json:"method,omitempty"`
+	// Host is a substring of the request's host, for example "sqs.".
+	Host string `json:"host,omitempty"`
+	// Path is a prefix of the request's path.
+	Path string `json:"path,omitempty"`
+	// Operation is the API operation name, from the X-Amz-Target header (JSON protocols)
+	// or the Action parameter (Query protocols).
+	Operation string `json:"operation,omitempty"`
+	// Status is the response's HTTP status code. Defaults to 200.
+	Status int `json:"status,omitempty"`
+	// Headers are the response's HTTP headers.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the response's body.
+	Body string `json:"body,omitempty"`
+}
+
+// vcrDefaultStubRules are the stub rules used after a test's own rules.
+// They allow the provider to be configured without an AWS account.
+var vcrDefaultStubRules = []VCRStubRule{
+	{
+		Operation: "GetCallerIdentity",
+		Headers:   map[string]string{"Content-Type": "text/xml"},
+		Body: fmt.Sprintf(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::%[1]d:user/vcr</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>%[1]d</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`, vcrScrubbedAccountID),
+	},
+}
+
+type vcrStubMap map[string]*vcrStub
+
+func (m vcrStubMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m vcrStubMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m vcrStubMap) key() string {
+	return "vcr-stubs"
+}
+
+var (
+	vcrStubs = vcrStubMap(make(map[string]*vcrStub, 0))
+)
+
+// vcrStub is a test's local stub server.
+type vcrStub struct {
+	mu     sync.Mutex
+	rules  []VCRStubRule
+	server *httptest.Server
+}
+
+// VCRStub adds rules to the test's local stub server.
+// Rules are matched in the order they are added, before any rules in the test's stub file.
+func VCRStub(t *testing.T, rules ...VCRStubRule) {
+	t.Helper()
+
+	stub := vcrStubForTest(t.Name())
+
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+
+	stub.rules = append(stub.rules, rules...)
+}
+
+func vcrStubForTest(testName string) *vcrStub {
+	vcrStubs.Lock()
+	defer vcrStubs.Unlock()
+
+	stub, ok := vcrStubs[testName]
+	if !ok {
+		stub = &vcrStub{}
+		vcrStubs[testName] = stub
+	}
+
+	return stub
+}
+
+// vcrStubFile returns the name of the file containing a test's stub rules.
+func vcrStubFile(path, name string) string {
+	return filepath.Join(path, fmt.Sprintf("%s.stub.json", vcrFileName(name)))
+}
+
+// vcrStubTransport returns an http.RoundTripper that sends requests to the test's stub server.
+// If the VCR_STUB_URL environment variable is set requests are sent to that URL instead, for example to
+// a locally running service emulator.
+func vcrStubTransport(testName string, transport http.RoundTripper) (http.RoundTripper, error) {
+	if v := os.Getenv(envVarVCRStubURL); v != "" {
+		u, err := url.Parse(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", envVarVCRStubURL, err)
+		}
+
+		return &vcrRedirectTransport{url: u, transport: transport}, nil
+	}
+
+	stub := vcrStubForTest(testName)
+
+	if err := stub.load(vcrStubFile(os.Getenv(envVarVCRPath), testName)); err != nil {
+		return nil, err
+	}
+
+	return &vcrRedirectTransport{url: stub.start(), transport: transport}, nil
+}
+
+// load appends the rules in the specified file, if it exists.
+func (s *vcrStub) load(fileName string) error {
+	b, err := os.ReadFile(fileName)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var rules []VCRStubRule
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return fmt.Errorf("reading stub rules (%s): %w", fileName, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rules = append(s.rules, rules...)
+
+	return nil
+}
+
+// start starts the stub server, if it is not already running, and returns its URL.
+func (s *vcrStub) start() *url.URL {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.server == nil {
+		s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	}
+
+	u, _ := url.Parse(s.server.URL)
+
+	return u
+}
+
+func (s *vcrStub) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.server != nil {
+		s.server.Close()
+		s.server = nil
+	}
+}
+
+func (s *vcrStub) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	operation := vcrOperation(r, body)
+
+	s.mu.Lock()
+	rules := slices.Concat(s.rules, vcrDefaultStubRules)
+	s.mu.Unlock()
+
+	for _, rule := range rules {
+		if !rule.matches(r, operation) {
+			continue
+		}
+
+		for k, v := range rule.Headers {
+			w.Header().Set(k, v)
+		}
+
+		status := rule.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		w.WriteHeader(status)
+		io.WriteString(w, rule.Body) //nolint:errcheck // Nothing to be done if the client has gone away.
+
+		return
+	}
+
+	// Respond with a non-retryable client error in the AWS Query protocol's error format.
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(http.StatusBadRequest)
+	fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>VCRInteractionNotFound</Code><Message>no recorded interaction or stub rule for %s %s%s (%s)</Message></Error></ErrorResponse>`, r.Method, r.Host, r.URL.Path, operation)
+}
+
+func (rule VCRStubRule) matches(r *http.Request, operation string) bool {
+	if rule.Method != "" && !strings.EqualFold(rule.Method, r.Method) {
+		return false
+	}
+
+	if rule.Host != "" && !strings.Contains(r.Host, rule.Host) {
+		return false
+	}
+
+	if rule.Path != "" && !strings.HasPrefix(r.URL.Path, rule.Path) {
+		return false
+	}
+
+	if rule.Operation != "" && rule.Operation != operation {
+		return false
+	}
+
+	return true
+}
+
+// vcrOperation returns the API operation name of a request using the JSON or Query protocols.
+func vcrOperation(r *http.Request, body []byte) string {
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		if _, after, ok := strings.Cut(v, "."); ok {
+			return after
+		}
+
+		return v
+	}
+
+	if v := r.URL.Query().Get("Action"); v != "" {
+		return v
+	}
+
+	if values, err := url.ParseQuery(string(body)); err == nil {
+		return values.Get("Action")
+	}
+
+	return ""
+}
+
+// vcrRedirectTransport sends requests to another URL, preserving the original Host header.
+type vcrRedirectTransport struct {
+	url       *url.URL
+	transport http.RoundTripper
+}
+
+func (t *vcrRedirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+
+	if r.Host == "" {
+		r.Host = r.URL.Host
+	}
+	r.URL.Scheme = t.url.Scheme
+	r.URL.Host = t.url.Host
+
+	return t.transport.RoundTrip(r)
+}
+
+// vcrReplayTransport replays recorded interactions, sending requests that don't match any recorded
+// interaction to a fallback transport.
+type vcrReplayTransport struct {
+	recorder *recorder.Recorder
+	fallback http.RoundTripper
+}
+
+func (t *vcrReplayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.recorder == nil {
+		return t.fallback.RoundTrip(r)
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	response, err := t.recorder.RoundTrip(r)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		if r.Body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		return t.fallback.RoundTrip(r)
+	}
+
+	return response, err
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batcher
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Batcher coalesces lookups of individual keys made concurrently within a short window
+// into a single call to a batch lookup function, fanning the results back out to the callers.
+type Batcher[K comparable, V any] struct {
+	lookup  func(context.Context, []K) (map[K]V, error)
+	window  time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending *batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	ctx     context.Context
+	keys    []K
+	once    sync.Once
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// New returns a new Batcher.
+// `lookup` is called with up to `maxSize` distinct keys collected during `window` and returns the values found, keyed by key.
+// Keys that aren't found must be omitted from the result rather than returned as an error.
+// `lookup` is called with the Context of the first caller in the batch, without its cancelation,
+// so any logging in that Context should be attributed to all the keys in the batch.
+func New[K comparable, V any](lookup func(context.Context, []K) (map[K]V, error), window time.Duration, maxSize int) *Batcher[K, V] {
+	return &Batcher[K, V]{
+		lookup:  lookup,
+		window:  window,
+		maxSize: maxSize,
+	}
+}
+
+// Get returns the value for the specified key.
+// The boolean result reports whether the key was found.
+// An error returned by the batch lookup is returned to every caller in the batch.
+func (b *Batcher[K, V]) Get(ctx context.Context, key K) (V, bool, error) {
+	var zero V
+
+	bt := b.add(ctx, key)
+
+	select {
+	case <-bt.done:
+	case <-ctx.Done():
+		return zero, false, ctx.Err()
+	}
+
+	if bt.err != nil {
+		return zero, false, bt.err
+	}
+
+	v, ok := bt.results[key]
+
+	return v, ok, nil
+}
+
+// add adds the specified key to the pending batch, starting a new batch if necessary.
+func (b *Batcher[K, V]) add(ctx context.Context, key K) *batch[K, V] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bt := b.pending
+	if bt == nil {
+		bt = &batch[K, V]{
+			// The lookup is shared by all callers in the batch so must not be canceled with the first caller.
+			// Attributing the lookup to all the callers is left to the lookup function, which knows the keys.
+			ctx:  context.WithoutCancel(ctx),
+			done: make(chan struct{}),
+		}
+		b.pending = bt
+		time.AfterFunc(b.window, func() { b.flush(bt) })
+	}
+
+	if !slices.Contains(bt.keys, key) {
+		bt.keys = append(bt.keys, key)
+	}
+
+	if b.maxSize > 0 && len(bt.keys) >= b.maxSize {
+		go b.flush(bt)
+		b.pending = nil
+	}
+
+	return bt
+}
+
+// flush performs the lookup for the specified batch, once.
+func (b *Batcher[K, V]) flush(bt *batch[K, V]) {
+	b.mu.Lock()
+	if b.pending == bt {
+		b.pending = nil
+	}
+	b.mu.Unlock()
+
+	bt.once.Do(func() {
+		bt.results, bt.err = b.lookup(bt.ctx, bt.keys)
+		close(bt.done)
+	})
+}
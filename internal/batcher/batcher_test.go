@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batcher_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/batcher"
+)
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keys            []string
+		maxSize         int
+		lookupErr       error
+		expectedLookups int
+		expectedErr     bool
+	}{
+		"single key": {
+			keys:            []string{"a"},
+			maxSize:         10,
+			expectedLookups: 1,
+		},
+		"coalesced": {
+			keys:            []string{"a", "b", "c", "missing"},
+			maxSize:         10,
+			expectedLookups: 1,
+		},
+		"duplicate keys": {
+			keys:            []string{"a", "a", "b"},
+			maxSize:         10,
+			expectedLookups: 1,
+		},
+		"max size": {
+			keys:            []string{"a", "b", "c", "d", "missing"},
+			maxSize:         2,
+			expectedLookups: 3,
+		},
+		"lookup error": {
+			keys:            []string{"a", "b"},
+			maxSize:         10,
+			lookupErr:       errors.New("throttled"),
+			expectedLookups: 1,
+			expectedErr:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu      sync.Mutex
+				lookups int
+			)
+			b := batcher.New(func(ctx context.Context, keys []string) (map[string]string, error) {
+				mu.Lock()
+				lookups++
+				mu.Unlock()
+
+				if testCase.lookupErr != nil {
+					return nil, testCase.lookupErr
+				}
+
+				results := make(map[string]string)
+				for _, key := range keys {
+					if key != "missing" {
+						results[key] = fmt.Sprintf("value-%s", key)
+					}
+				}
+
+				return results, nil
+			}, 50*time.Millisecond, testCase.maxSize)
+
+			ctx := context.Background()
+			var wg sync.WaitGroup
+			for _, key := range testCase.keys {
+				key := key
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					v, ok, err := b.Get(ctx, key)
+
+					if got, expected := err != nil, testCase.expectedErr; got != expected {
+						t.Errorf("incorrect error for %s. Expected: %t, got: %t (%v)", key, expected, got, err)
+					}
+
+					if err != nil {
+						return
+					}
+
+					if got, expected := ok, key != "missing"; got != expected {
+						t.Errorf("incorrect found for %s. Expected: %t, got: %t", key, expected, got)
+					}
+
+					if got, expected := v, fmt.Sprintf("value-%s", key); ok && got != expected {
+						t.Errorf("incorrect value. Expected: %s, got: %s", expected, got)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got, expected := lookups, testCase.expectedLookups; got != expected {
+				t.Errorf("incorrect number of lookups. Expected: %d, got: %d", expected, got)
+			}
+		})
+	}
+}
+
+func TestBatcherCanceled(t *testing.T) {
+	t.Parallel()
+
+	var keys []string
+	done := make(chan struct{})
+	b := batcher.New(func(ctx context.Context, ks []string) (map[string]string, error) {
+		defer close(done)
+
+		keys = ks
+
+		return map[string]string{"a": "value-a", "b": "value-b"}, nil
+	}, 50*time.Millisecond, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, _, err := b.Get(ctx, "a"); !errors.Is(err, context.Canceled) {
+		t.Errorf("incorrect error. Expected: %v, got: %v", context.Canceled, err)
+	}
+
+	// The batch is still looked up for the remaining callers.
+	v, ok, err := b.Get(context.Background(), "b")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !ok || v != "value-b" {
+		t.Errorf("incorrect value. Expected: value-b, got: %s", v)
+	}
+
+	<-done
+
+	if expected := []string{"a", "b"}; !slices.Equal(keys, expected) {
+		t.Errorf("incorrect keys. Expected: %v, got: %v", expected, keys)
+	}
+}
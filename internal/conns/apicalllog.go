@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// apiCallLogEntry is a single line in the API call log.
+type apiCallLogEntry struct {
+	Time              time.Time `json:"time"`
+	Service           string    `json:"service"`
+	Operation         string    `json:"operation"`
+	Region            string    `json:"region"`
+	ResourceType      string    `json:"resource_type,omitempty"`
+	ResourceID        string    `json:"resource_id,omitempty"`
+	ResourceOperation string    `json:"resource_operation,omitempty"`
+	LatencyMS         int64     `json:"latency_ms"`
+	RetryCount        int       `json:"retry_count"`
+	ErrorCode         string    `json:"error_code,omitempty"`
+}
+
+// apiCallLogger writes API call log entries as JSON Lines.
+type apiCallLogger struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+func newAPICallLogger(w io.Writer) *apiCallLogger {
+	return &apiCallLogger{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+var (
+	apiCallLoggersLock sync.Mutex
+	apiCallLoggers     = make(map[string]*apiCallLogger) // Keyed by file name.
+)
+
+// apiCallLoggerForFile returns the API call logger that appends to the specified file.
+// Provider instances configured with the same file name share a logger.
+func apiCallLoggerForFile(name string) (*apiCallLogger, error) {
+	apiCallLoggersLock.Lock()
+	defer apiCallLoggersLock.Unlock()
+
+	if v, ok := apiCallLoggers[name]; ok {
+		return v, nil
+	}
+
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v := newAPICallLogger(f)
+	apiCallLoggers[name] = v
+
+	return v, nil
+}
+
+func (l *apiCallLogger) log(ctx context.Context, entry apiCallLogEntry) {
+	if v, ok := apiCallResourceFromContext(ctx); ok {
+		entry.ResourceType = v.typeName
+		entry.ResourceOperation = v.operation
+		if v.id != nil {
+			entry.ResourceID = v.id()
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Errors writing the log are ignored so as not to fail the API call.
+	_ = l.encoder.Encode(entry)
+}
+
+type apiCallResourceContextKeyType int
+
+var apiCallResourceContextKey apiCallResourceContextKeyType
+
+// apiCallResource represents the Terraform resource or data source on whose behalf AWS API calls are made.
+// The resource's address is not part of the plugin protocol, so the resource is identified by its type and ID.
+type apiCallResource struct {
+	typeName  string
+	id        func() string // Called lazily as the ID is not known before Create.
+	operation string
+}
+
+// NewAPICallLogContext returns a Context that attributes AWS API calls to the specified Terraform resource or data source.
+func NewAPICallLogContext(ctx context.Context, typeName string, id func() string, operation string) context.Context {
+	v := apiCallResource{
+		typeName:  typeName,
+		id:        id,
+		operation: operation,
+	}
+
+	return context.WithValue(ctx, apiCallResourceContextKey, &v)
+}
+
+// NewAPICallLogBatchContext returns a Context that attributes AWS API calls made once on behalf of several resources or data sources
+// of the type already attributed in the Context, for example by coalescing their reads, to all the resources' or data sources' IDs.
+func NewAPICallLogBatchContext(ctx context.Context, ids []string) context.Context {
+	v, ok := apiCallResourceFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	id := strings.Join(ids, ",")
+
+	return NewAPICallLogContext(ctx, v.typeName, func() string { return id }, v.operation)
+}
+
+func apiCallResourceFromContext(ctx context.Context) (*apiCallResource, bool) {
+	v, ok := ctx.Value(apiCallResourceContextKey).(*apiCallResource)
+	return v, ok
+}
+
+// addAPICallLogMiddleware returns an AWS SDK for Go v2 API option that adds API call logging to the middleware stack.
+// The middleware is added at the end of the Initialize step so that the service metadata is available and all retries are counted.
+func addAPICallLogMiddleware(l *apiCallLogger) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(apiCallLogMiddleware(l), middleware.After)
+	}
+}
+
+func apiCallLogMiddleware(l *apiCallLogger) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(
+		"TerraformProviderAPICallLog",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			entry := apiCallLogEntry{
+				Time:      start.UTC(),
+				Service:   awsmiddleware.GetServiceID(ctx),
+				Operation: awsmiddleware.GetOperationName(ctx),
+				Region:    awsmiddleware.GetRegion(ctx),
+				LatencyMS: time.Since(start).Milliseconds(),
+			}
+
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 1 {
+				entry.RetryCount = len(v.Results) - 1
+			}
+
+			if err != nil {
+				var apiErr smithy.APIError
+				if errors.As(err, &apiErr) {
+					entry.ErrorCode = apiErr.ErrorCode()
+				}
+			}
+
+			l.log(ctx, entry)
+
+			return out, metadata, err
+		},
+	)
+}
+
+// apiCallLogHandler returns an AWS SDK for Go v1 request handler that logs completed API calls.
+func apiCallLogHandler(l *apiCallLogger) request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "TerraformProviderAPICallLog",
+		Fn: func(r *request_sdkv1.Request) {
+			entry := apiCallLogEntry{
+				Time:       r.Time.UTC(),
+				Service:    r.ClientInfo.ServiceID,
+				Region:     r.ClientInfo.SigningRegion,
+				LatencyMS:  time.Since(r.Time).Milliseconds(),
+				RetryCount: r.RetryCount,
+			}
+
+			if r.Operation != nil {
+				entry.Operation = r.Operation.Name
+			}
+
+			if r.Config.Region != nil {
+				entry.Region = *r.Config.Region
+			}
+
+			var awsErr awserr.Error
+			if errors.As(r.Error, &awsErr) {
+				entry.ErrorCode = awsErr.Code()
+			}
+
+			l.log(r.Context(), entry)
+		},
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAPICallLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		err      error
+		expected apiCallLogEntry
+	}{
+		"no resource": {
+			ctx: context.Background(),
+			expected: apiCallLogEntry{
+				Service:   "IAM",
+				Operation: "GetRole",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+		},
+		"resource": {
+			ctx: NewAPICallLogContext(context.Background(), "aws_iam_role", func() string { return "example" }, "read"),
+			expected: apiCallLogEntry{
+				Service:           "IAM",
+				Operation:         "GetRole",
+				Region:            "us-west-2", //lintignore:AWSAT003
+				ResourceType:      "aws_iam_role",
+				ResourceID:        "example",
+				ResourceOperation: "read",
+			},
+		},
+		"batch": {
+			ctx: NewAPICallLogBatchContext(NewAPICallLogContext(context.Background(), "aws_iam_role", func() string { return "example1" }, "read"), []string{"example1", "example2"}),
+			expected: apiCallLogEntry{
+				Service:           "IAM",
+				Operation:         "GetRole",
+				Region:            "us-west-2", //lintignore:AWSAT003
+				ResourceType:      "aws_iam_role",
+				ResourceID:        "example1,example2",
+				ResourceOperation: "read",
+			},
+		},
+		"batch no resource": {
+			ctx: NewAPICallLogBatchContext(context.Background(), []string{"example1", "example2"}),
+			expected: apiCallLogEntry{
+				Service:   "IAM",
+				Operation: "GetRole",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+		},
+		"error": {
+			ctx: context.Background(),
+			err: &smithy.GenericAPIError{Code: "NoSuchEntity"},
+			expected: apiCallLogEntry{
+				Service:   "IAM",
+				Operation: "GetRole",
+				Region:    "us-west-2", //lintignore:AWSAT003
+				ErrorCode: "NoSuchEntity",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			l := newAPICallLogger(&buf)
+
+			stack := middleware.NewStack("test", func() interface{} { return struct{}{} })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "IAM",
+				Region:        "us-west-2", //lintignore:AWSAT003
+				OperationName: "GetRole",
+			}, middleware.Before); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := addAPICallLogMiddleware(l)(stack); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, interface{}) (interface{}, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, testCase.err
+			}), stack)
+
+			if _, _, err := handler.Handle(testCase.ctx, struct{}{}); err != testCase.err { //nolint:errorlint // Expect the error unchanged
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got apiCallLogEntry
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Time.IsZero() {
+				t.Error("expected time to be set")
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreFields(apiCallLogEntry{}, "Time", "LatencyMS")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
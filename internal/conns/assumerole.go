@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// assumeRoleChain returns a credentials provider that, starting from the credentials in the specified configuration,
+// assumes each of the specified IAM Roles in turn. The session for each role is used to assume the next.
+// Each role is assumed once to verify the chain, surfacing the failing hop in any error.
+func assumeRoleChain(ctx context.Context, cfg aws_sdkv2.Config, roles []*awsbase.AssumeRole, stsRegion, stsEndpoint string) (aws_sdkv2.CredentialsProvider, error) {
+	for i, role := range roles {
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.index":           i,
+			"tf_aws.assume_role.role_arn":        role.RoleARN,
+			"tf_aws.assume_role.session_name":    role.SessionName,
+			"tf_aws.assume_role.external_id":     role.ExternalID,
+			"tf_aws.assume_role.source_identity": role.SourceIdentity,
+		})
+
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+		})
+		provider := aws_sdkv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, role.RoleARN, assumeRoleOptions(role)))
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s): %w", role.RoleARN, err)
+		}
+
+		cfg.Credentials = provider
+	}
+
+	return cfg.Credentials, nil
+}
+
+// assumeRoleOptions returns a function that sets AssumeRole API options from the specified configuration.
+func assumeRoleOptions(role *awsbase.AssumeRole) func(*stscreds.AssumeRoleOptions) {
+	return func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = role.SessionName
+		o.Duration = role.Duration
+
+		if role.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(role.ExternalID)
+		}
+
+		if role.Policy != "" {
+			o.Policy = aws_sdkv2.String(role.Policy)
+		}
+
+		for _, v := range role.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		for k, v := range role.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		o.TransitiveTagKeys = role.TransitiveTagKeys
+
+		if role.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(role.SourceIdentity)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		roles               []*awsbase.AssumeRole
+		failRoleARN         string
+		expectedAccessKeyID string
+		expectedCalls       []string
+		expectedErr         bool
+	}{
+		"no roles": {
+			expectedAccessKeyID: "base",
+		},
+		"one role": {
+			roles: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/hub", SessionName: "hub"},
+			},
+			expectedAccessKeyID: "hub",
+			expectedCalls: []string{
+				"base>hub",
+			},
+		},
+		"two roles": {
+			roles: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/hub", SessionName: "hub"},
+				{RoleARN: "arn:aws:iam::222222222222:role/workload", SessionName: "workload", ExternalID: "example"},
+			},
+			expectedAccessKeyID: "workload",
+			expectedCalls: []string{
+				"base>hub",
+				"hub>workload",
+			},
+		},
+		"second role fails": {
+			roles: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/hub", SessionName: "hub"},
+				{RoleARN: "arn:aws:iam::222222222222:role/workload", SessionName: "workload"},
+			},
+			failRoleARN: "arn:aws:iam::222222222222:role/workload",
+			expectedCalls: []string{
+				"base>hub",
+				"hub>workload",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			// Mock STS AssumeRole. The returned access key ID is the assumed role's name.
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				// The caller's access key ID is the first part of the request signature's credential scope.
+				_, scope, _ := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+				caller, _, _ := strings.Cut(scope, "/")
+				roleARN := r.PostForm.Get("RoleArn")
+				calls = append(calls, caller+">"+path.Base(roleARN))
+
+				if roleARN == testCase.failRoleARN {
+					w.WriteHeader(http.StatusForbidden)
+					fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error></ErrorResponse>`)
+					return
+				}
+
+				fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>%s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>%s</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`,
+					path.Base(roleARN), time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			cfg := aws_sdkv2.Config{
+				Credentials: credentials.NewStaticCredentialsProvider("base", "secret", ""),
+				Region:      "us-west-2", //lintignore:AWSAT003
+			}
+
+			provider, err := assumeRoleChain(ctx, cfg, testCase.roles, "", server.URL)
+
+			if got, expected := err != nil, testCase.expectedErr; got != expected {
+				t.Fatalf("incorrect error. Expected: %t, got: %t (%v)", expected, got, err)
+			}
+
+			if diff := cmp.Diff(calls, testCase.expectedCalls); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if err != nil {
+				return
+			}
+
+			credentials, err := provider.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("retrieving credentials: %s", err)
+			}
+
+			if got, expected := credentials.AccessKeyID, testCase.expectedAccessKeyID; got != expected {
+				t.Errorf("incorrect access key ID. Expected: %s, got: %s", expected, got)
+			}
+		})
+	}
+}
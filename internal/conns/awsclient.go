@@ -9,8 +9,10 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
@@ -22,6 +24,7 @@ import (
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/batcher"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -36,6 +39,8 @@ type AWSClient struct {
 	ServicePackages   map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
+	batchers                  map[string]any // Keyed by name.
+	batchersLock              sync.Mutex
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
@@ -43,11 +48,22 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	permissionsAllowed        map[string]bool // Keyed by IAM action.
+	permissionsLock           sync.Mutex
+	permissionsPreflightMode  string // From provider configuration.
+	permissionsPrincipalARN   string
+	rateLimiters              map[string]*rateLimiter // From provider configuration.
+	regionalClients           map[string]*AWSClient   // Keyed by Region.
+	regionalLock              sync.Mutex
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagPolicy                 *tftags.Policy
+	tagPolicyComplianceMode   string // From provider configuration.
+	tagPolicyLoaded           bool
+	tagPolicyLock             sync.Mutex
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -100,6 +116,7 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
+	c = c.forContext(ctx)
 	s3Client := c.S3Client(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
@@ -250,6 +267,11 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if r, ok := c.rateLimiters[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), addRateLimiterMiddleware(r))
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -324,6 +346,7 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
+	c = c.forContext(ctx)
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -386,6 +409,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
+	c = c.forContext(ctx)
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -433,3 +457,26 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	return client, nil
 }
+
+// Batcher returns the batcher with the specified name, creating it if necessary.
+// Batchers are per AWS Region so that each coalesces lookups made using a single API client.
+// This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
+func Batcher[K comparable, V any](ctx context.Context, c *AWSClient, name string, lookup func(context.Context, []K) (map[K]V, error), window time.Duration, maxSize int) *batcher.Batcher[K, V] {
+	c = c.forContext(ctx)
+
+	c.batchersLock.Lock()
+	defer c.batchersLock.Unlock()
+
+	if v, ok := c.batchers[name].(*batcher.Batcher[K, V]); ok {
+		return v
+	}
+
+	if c.batchers == nil {
+		c.batchers = make(map[string]any)
+	}
+
+	v := batcher.New(lookup, window, maxSize)
+	c.batchers[name] = v
+
+	return v
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
@@ -29,7 +30,8 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APICallLogFile                 string
+	AssumeRole                     []*awsbase.AssumeRole // In the order in which the roles are assumed.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -44,7 +46,9 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PermissionsPreflightMode       string
 	Profile                        string
+	RateLimits                     map[string]RateLimit // Keyed by service package name.
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -57,6 +61,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyComplianceMode        string
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -114,8 +119,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first role is assumed by the base library, using any web identity role as the source credentials.
+	// The provider assumes any further roles in turn.
+	assumeRoles := tfslices.Filter(c.AssumeRole, func(v *awsbase.AssumeRole) bool {
+		return v != nil && v.RoleARN != ""
+	})
+	if len(assumeRoles) > 0 {
+		awsbaseConfig.AssumeRole = assumeRoles[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -159,6 +169,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(assumeRoles) > 1 {
+		credentials, err := assumeRoleChain(ctx, cfg, assumeRoles[1:], c.STSRegion, c.Endpoints[names.STS])
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "Cannot assume IAM Role: %s", err)
+		}
+		cfg.Credentials = credentials
+	}
+
+	var apiCallLogger *apiCallLogger
+	if c.APICallLogFile != "" {
+		var err error
+		apiCallLogger, err = apiCallLoggerForFile(c.APICallLogFile)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening API call log file (%s): %s", c.APICallLogFile, err)
+		}
+
+		cfg.APIOptions = append(cfg.APIOptions, addAPICallLogMiddleware(apiCallLogger))
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -183,6 +213,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if apiCallLogger != nil {
+		session.Handlers.Complete.PushBackNamed(apiCallLogHandler(apiCallLogger))
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -224,9 +258,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.permissionsPreflightMode = c.PermissionsPreflightMode
+	client.rateLimiters = newRateLimiters(c.RateLimits)
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.tagPolicyComplianceMode = c.TagPolicyComplianceMode
 
 	return client, diags
 }
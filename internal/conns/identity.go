@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ImportIdentity represents a resource identity specified as an import ID.
+type ImportIdentity struct {
+	// Attributes are the natural key attribute values.
+	Attributes map[string]string
+	// ID is the equivalent legacy import ID.
+	ID string
+}
+
+// IsImportIdentity returns whether the specified import ID is a resource identity object
+// rather than a legacy import ID string.
+func IsImportIdentity(id string) bool {
+	return strings.HasPrefix(strings.TrimSpace(id), "{")
+}
+
+// ParseImportIdentity parses an import ID that is a JSON object of a resource's identity attributes, e.g.
+//
+//	{"zone_id":"Z1D633PJN98FT9","name":"www.example.com","type":"A"}
+//
+// The object may also contain `account_id` and, for Regional resources, `region` attributes.
+// These must match the provider's configured AWS account and the AWS Region kept in Context or configured in the provider.
+// Natural key attributes that are not specified are empty and trailing empty values are
+// omitted from the equivalent legacy import ID.
+func (c *AWSClient) ParseImportIdentity(ctx context.Context, identity *types.ServicePackageResourceIdentity, id string) (*ImportIdentity, error) {
+	var m map[string]any
+	if err := json.Unmarshal([]byte(id), &m); err != nil {
+		return nil, fmt.Errorf("parsing resource identity: %w", err)
+	}
+
+	result := &ImportIdentity{
+		Attributes: make(map[string]string),
+	}
+
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("resource identity attribute %q: expected string, got %T", k, v)
+		}
+
+		switch {
+		case k == names.AttrAccountID:
+			if s != c.AccountID {
+				return nil, fmt.Errorf("resource identity account ID (%s) does not match the provider's account ID (%s)", s, c.AccountID)
+			}
+		case k == names.AttrRegion && !identity.Global:
+			if region := c.forContext(ctx).Region; s != region {
+				return nil, fmt.Errorf("resource identity Region (%s) does not match the Region (%s)", s, region)
+			}
+		case slices.Contains(identity.Attributes, k):
+			result.Attributes[k] = s
+		default:
+			return nil, fmt.Errorf("unexpected resource identity attribute %q, expected one of: %s", k, strings.Join(identityAttributeNames(identity), ", "))
+		}
+	}
+
+	if len(result.Attributes) == 0 {
+		return nil, fmt.Errorf("no resource identity attributes specified, expected: %s", strings.Join(identity.Attributes, ", "))
+	}
+
+	parts := make([]string, len(identity.Attributes))
+	for i, k := range identity.Attributes {
+		parts[i] = result.Attributes[k]
+	}
+	for len(parts) > 0 && parts[len(parts)-1] == "" {
+		parts = parts[:len(parts)-1]
+	}
+	result.ID = strings.Join(parts, identity.Separator)
+
+	return result, nil
+}
+
+// ChangedIdentityAttributes returns those of a resource's natural key attributes whose values were
+// previously known and are different after refresh.
+func ChangedIdentityAttributes(identity *types.ServicePackageResourceIdentity, before, after map[string]string) []string {
+	var changed []string
+
+	for _, k := range identity.Attributes {
+		if v := before[k]; v != "" && v != after[k] {
+			changed = append(changed, k)
+		}
+	}
+
+	return changed
+}
+
+// identityAttributeNames returns the names of all attributes that may be specified in a resource identity.
+func identityAttributeNames(identity *types.ServicePackageResourceIdentity) []string {
+	attributeNames := []string{names.AttrAccountID}
+	if !identity.Global {
+		attributeNames = append(attributeNames, names.AttrRegion)
+	}
+
+	return append(attributeNames, identity.Attributes...)
+}
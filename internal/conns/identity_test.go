@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestIsImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		expected bool
+	}{
+		"empty": {},
+		"legacy": {
+			id: "Z1D633PJN98FT9_www.example.com_A",
+		},
+		"object": {
+			id:       `{"name":"www.example.com"}`,
+			expected: true,
+		},
+		"object with leading space": {
+			id:       ` {"name":"www.example.com"}`,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := IsImportIdentity(testCase.id), testCase.expected; got != expected {
+				t.Errorf("incorrect result. Expected: %t, got: %t", expected, got)
+			}
+		})
+	}
+}
+
+func TestParseImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	regional := &types.ServicePackageResourceIdentity{
+		Attributes: []string{"agent_id", "agent_version", "knowledge_base_id"},
+		Separator:  ",",
+	}
+	global := &types.ServicePackageResourceIdentity{
+		Attributes: []string{"zone_id", "name", "type", "set_identifier"},
+		Global:     true,
+		Separator:  "_",
+	}
+	client := &AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		identity    *types.ServicePackageResourceIdentity
+		ctx         context.Context
+		id          string
+		expected    *ImportIdentity
+		expectedErr bool
+	}{
+		"invalid JSON": {
+			identity:    regional,
+			id:          `{"agent_id":`,
+			expectedErr: true,
+		},
+		"not a string": {
+			identity:    regional,
+			id:          `{"agent_id":42}`,
+			expectedErr: true,
+		},
+		"no attributes": {
+			identity:    regional,
+			id:          `{}`,
+			expectedErr: true,
+		},
+		"unexpected attribute": {
+			identity:    regional,
+			id:          `{"agent_id":"A1","name":"example"}`,
+			expectedErr: true,
+		},
+		"regional": {
+			identity: regional,
+			id:       `{"agent_id":"A1","agent_version":"DRAFT","knowledge_base_id":"K1"}`,
+			expected: &ImportIdentity{
+				Attributes: map[string]string{
+					"agent_id":          "A1",
+					"agent_version":     "DRAFT",
+					"knowledge_base_id": "K1",
+				},
+				ID: "A1,DRAFT,K1",
+			},
+		},
+		"regional with account and Region": {
+			identity: regional,
+			id:       `{"account_id":"123456789012","region":"us-west-2","agent_id":"A1","agent_version":"DRAFT","knowledge_base_id":"K1"}`, //lintignore:AWSAT003
+			expected: &ImportIdentity{
+				Attributes: map[string]string{
+					"agent_id":          "A1",
+					"agent_version":     "DRAFT",
+					"knowledge_base_id": "K1",
+				},
+				ID: "A1,DRAFT,K1",
+			},
+		},
+		"wrong account": {
+			identity:    regional,
+			id:          `{"account_id":"210987654321","agent_id":"A1","agent_version":"DRAFT","knowledge_base_id":"K1"}`,
+			expectedErr: true,
+		},
+		"wrong Region": {
+			identity:    regional,
+			id:          `{"region":"us-east-1","agent_id":"A1","agent_version":"DRAFT","knowledge_base_id":"K1"}`, //lintignore:AWSAT003
+			expectedErr: true,
+		},
+		"Region in Context": {
+			identity: regional,
+			ctx:      NewRegionContext(context.Background(), "us-east-1"),                                       //lintignore:AWSAT003
+			id:       `{"region":"us-east-1","agent_id":"A1","agent_version":"DRAFT","knowledge_base_id":"K1"}`, //lintignore:AWSAT003
+			expected: &ImportIdentity{
+				Attributes: map[string]string{
+					"agent_id":          "A1",
+					"agent_version":     "DRAFT",
+					"knowledge_base_id": "K1",
+				},
+				ID: "A1,DRAFT,K1",
+			},
+		},
+		"global": {
+			identity: global,
+			id:       `{"zone_id":"Z1D633PJN98FT9","name":"www.example.com","type":"A"}`,
+			expected: &ImportIdentity{
+				Attributes: map[string]string{
+					"zone_id": "Z1D633PJN98FT9",
+					"name":    "www.example.com",
+					"type":    "A",
+				},
+				ID: "Z1D633PJN98FT9_www.example.com_A",
+			},
+		},
+		"global with optional attribute": {
+			identity: global,
+			id:       `{"zone_id":"Z1D633PJN98FT9","name":"www.example.com","type":"A","set_identifier":"primary"}`,
+			expected: &ImportIdentity{
+				Attributes: map[string]string{
+					"zone_id":        "Z1D633PJN98FT9",
+					"name":           "www.example.com",
+					"type":           "A",
+					"set_identifier": "primary",
+				},
+				ID: "Z1D633PJN98FT9_www.example.com_A_primary",
+			},
+		},
+		"global with Region": {
+			identity:    global,
+			id:          `{"region":"us-west-2","zone_id":"Z1D633PJN98FT9","name":"www.example.com","type":"A"}`, //lintignore:AWSAT003
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			got, err := client.ParseImportIdentity(ctx, testCase.identity, testCase.id)
+
+			if got, expected := err != nil, testCase.expectedErr; got != expected {
+				t.Fatalf("incorrect error. Expected: %t, got: %t (%v)", expected, got, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangedIdentityAttributes(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []string{"zone_id", "name", "type", "set_identifier"},
+	}
+
+	testCases := map[string]struct {
+		before   map[string]string
+		after    map[string]string
+		expected []string
+	}{
+		"no prior state": {
+			after: map[string]string{"zone_id": "Z1", "name": "www.example.com", "type": "A"},
+		},
+		"unchanged": {
+			before: map[string]string{"zone_id": "Z1", "name": "www.example.com", "type": "A"},
+			after:  map[string]string{"zone_id": "Z1", "name": "www.example.com", "type": "A"},
+		},
+		"newly set": {
+			before: map[string]string{"zone_id": "Z1", "name": "www.example.com", "type": "A"},
+			after:  map[string]string{"zone_id": "Z1", "name": "www.example.com", "type": "A", "set_identifier": "primary"},
+		},
+		"changed": {
+			before:   map[string]string{"zone_id": "Z1", "name": "www.example.com", "type": "A"},
+			after:    map[string]string{"zone_id": "Z2", "name": "www.example.com", "type": "CNAME"},
+			expected: []string{"zone_id", "type"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ChangedIdentityAttributes(identity, testCase.before, testCase.after)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
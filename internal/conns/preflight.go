@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Permissions preflight modes.
+const (
+	PermissionsPreflightModeError   = "error"
+	PermissionsPreflightModeWarning = "warning"
+)
+
+func PermissionsPreflightMode_Values() []string {
+	return []string{
+		PermissionsPreflightModeError,
+		PermissionsPreflightModeWarning,
+	}
+}
+
+// PermissionsPreflightActions returns the IAM actions checked during plan for a resource with the specified permissions.
+// A planned create requires the create and read actions, a planned update the update and read actions.
+func PermissionsPreflightActions(permissions *types.ServicePackageResourcePermissions, create bool) []string {
+	if permissions == nil {
+		return nil
+	}
+
+	var actions []string
+
+	if create {
+		actions = append(actions, permissions.Create...)
+	} else {
+		actions = append(actions, permissions.Update...)
+	}
+	actions = append(actions, permissions.Read...)
+
+	return actions
+}
+
+// PermissionsPreflightMode returns the configured permissions preflight mode.
+// An empty string indicates that permissions are not checked during plan.
+func (c *AWSClient) PermissionsPreflightMode() string {
+	return c.permissionsPreflightMode
+}
+
+// MissingPermissions returns those of the specified IAM actions that the caller identity is not allowed to perform.
+// Results are cached per action for the lifetime of the client.
+// The cache lock is not held while calling AWS APIs, so concurrent plans of resources with uncached actions may simulate the same action more than once.
+func (c *AWSClient) MissingPermissions(ctx context.Context, actions []string) ([]string, error) {
+	c.permissionsLock.Lock()
+	principalARN := c.permissionsPrincipalARN
+	var uncached []string
+	for _, action := range actions {
+		if _, ok := c.permissionsAllowed[action]; !ok && !slices.Contains(uncached, action) {
+			uncached = append(uncached, action)
+		}
+	}
+	c.permissionsLock.Unlock()
+
+	if len(uncached) > 0 {
+		if principalARN == "" {
+			var err error
+			principalARN, err = c.callerPrincipalARN(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			c.permissionsLock.Lock()
+			c.permissionsPrincipalARN = principalARN
+			c.permissionsLock.Unlock()
+		}
+
+		allowed, err := c.simulatePrincipalPolicy(ctx, principalARN, uncached)
+
+		if err != nil {
+			return nil, err
+		}
+
+		c.permissionsLock.Lock()
+		if c.permissionsAllowed == nil {
+			c.permissionsAllowed = make(map[string]bool)
+		}
+		for action, v := range allowed {
+			c.permissionsAllowed[action] = v
+		}
+		c.permissionsLock.Unlock()
+	}
+
+	c.permissionsLock.Lock()
+	defer c.permissionsLock.Unlock()
+
+	var missing []string
+	for _, action := range actions {
+		if !c.permissionsAllowed[action] && !slices.Contains(missing, action) {
+			missing = append(missing, action)
+		}
+	}
+
+	return missing, nil
+}
+
+// simulatePrincipalPolicy returns whether the specified IAM principal is allowed to perform each of the specified IAM actions.
+func (c *AWSClient) simulatePrincipalPolicy(ctx context.Context, principalARN string, actions []string) (map[string]bool, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     actions,
+		PolicySourceArn: aws.String(principalARN),
+	}
+	allowed := make(map[string]bool, len(actions))
+
+	pages := iam.NewSimulatePrincipalPolicyPaginator(c.IAMClient(ctx), input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("simulating IAM Principal (%s) policy: %w", principalARN, err)
+		}
+
+		for _, v := range page.EvaluationResults {
+			allowed[aws.ToString(v.EvalActionName)] = v.EvalDecision == awstypes.PolicyEvaluationDecisionTypeAllowed
+		}
+	}
+
+	return allowed, nil
+}
+
+// callerPrincipalARN returns the ARN of the IAM principal (user or role) that the caller identity represents.
+func (c *AWSClient) callerPrincipalARN(ctx context.Context) (string, error) {
+	output, err := c.STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		return "", fmt.Errorf("reading STS Caller Identity: %w", err)
+	}
+
+	callerARN := aws.ToString(output.Arn)
+
+	roleName, ok := assumedRoleName(callerARN)
+	if !ok {
+		return callerARN, nil
+	}
+
+	// The assumed role's ARN doesn't include any role path.
+	role, err := c.IAMClient(ctx).GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(roleName),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("reading IAM Role (%s): %w", roleName, err)
+	}
+
+	return aws.ToString(role.Role.Arn), nil
+}
+
+// assumedRoleName returns the name of the IAM role from an STS assumed-role ARN.
+// e.g. arn:aws:sts::123456789012:assumed-role/example/session returns "example".
+func assumedRoleName(s string) (string, bool) {
+	v, err := arn.Parse(s)
+
+	if err != nil || v.Service != "sts" {
+		return "", false
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "assumed-role" || parts[1] == "" {
+		return "", false
+	}
+
+	return parts[1], true
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestAssumedRoleName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn        string
+		expected   string
+		expectedOK bool
+	}{
+		"empty": {},
+		"invalid ARN": {
+			arn: "example",
+		},
+		"IAM user": {
+			arn: "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+		},
+		"IAM role": {
+			arn: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+		},
+		"assumed role": {
+			arn:        "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+			expected:   "example",
+			expectedOK: true,
+		},
+		"federated user": {
+			arn: "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := assumedRoleName(testCase.arn)
+
+			if got, expected := ok, testCase.expectedOK; got != expected {
+				t.Fatalf("incorrect OK. Expected: %t, got: %t", expected, got)
+			}
+
+			if got, expected := got, testCase.expected; got != expected {
+				t.Errorf("incorrect role name. Expected: %s, got: %s", expected, got)
+			}
+		})
+	}
+}
+
+func TestPermissionsPreflightActions(t *testing.T) {
+	t.Parallel()
+
+	permissions := &types.ServicePackageResourcePermissions{
+		Create: []string{"iam:CreateRole", "iam:TagRole"},
+		Read:   []string{"iam:GetRole"},
+		Update: []string{"iam:UpdateRole"},
+		Delete: []string{"iam:DeleteRole"},
+	}
+
+	testCases := map[string]struct {
+		permissions *types.ServicePackageResourcePermissions
+		create      bool
+		expected    []string
+	}{
+		"nil": {
+			create: true,
+		},
+		"create": {
+			permissions: permissions,
+			create:      true,
+			expected:    []string{"iam:CreateRole", "iam:TagRole", "iam:GetRole"},
+		},
+		"update": {
+			permissions: permissions,
+			expected:    []string{"iam:UpdateRole", "iam:GetRole"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := PermissionsPreflightActions(testCase.permissions, testCase.create)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
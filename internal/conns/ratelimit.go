@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+// RateLimit is a client-side limit on the AWS API calls made to a single service.
+// A zero value for either field means no limit.
+type RateLimit struct {
+	MaxConcurrency    int
+	RequestsPerSecond float64
+}
+
+// rateLimiter enforces a RateLimit.
+type rateLimiter struct {
+	semaphore tfsync.Semaphore // Nil if concurrency is unlimited.
+	interval  time.Duration    // Zero if request rate is unlimited.
+
+	lock sync.Mutex
+	next time.Time // Earliest time the next request can be sent.
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	r := &rateLimiter{}
+
+	if limit.MaxConcurrency > 0 {
+		r.semaphore = tfsync.NewSemaphore(limit.MaxConcurrency)
+	}
+
+	if limit.RequestsPerSecond > 0 {
+		r.interval = time.Duration(float64(time.Second) / limit.RequestsPerSecond)
+	}
+
+	return r
+}
+
+// newRateLimiters returns the rate limiters for the specified per-service package limits.
+func newRateLimiters(limits map[string]RateLimit) map[string]*rateLimiter {
+	rateLimiters := make(map[string]*rateLimiter, len(limits))
+
+	for servicePackageName, limit := range limits {
+		if limit.MaxConcurrency <= 0 && limit.RequestsPerSecond <= 0 {
+			continue
+		}
+
+		rateLimiters[servicePackageName] = newRateLimiter(limit)
+	}
+
+	return rateLimiters
+}
+
+// acquire blocks until a request can be sent or the Context is done.
+// If no error is returned the caller must call release once the request has completed.
+func (r *rateLimiter) acquire(ctx context.Context) error {
+	if r.semaphore != nil {
+		if err := r.semaphore.WaitContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	if r.interval > 0 {
+		r.lock.Lock()
+		now := time.Now()
+		if r.next.Before(now) {
+			r.next = now
+		}
+		delay := r.next.Sub(now)
+		r.next = r.next.Add(r.interval)
+		r.lock.Unlock()
+
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				r.release()
+				return ctx.Err()
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *rateLimiter) release() {
+	if r.semaphore != nil {
+		r.semaphore.Notify()
+	}
+}
+
+// addRateLimiterMiddleware returns an AWS SDK for Go v2 API option that adds the rate limiter to the middleware stack.
+// The middleware is inserted after the retry middleware so that each attempt is limited.
+func addRateLimiterMiddleware(r *rateLimiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Insert(rateLimiterMiddleware(r), "Retry", middleware.After)
+	}
+}
+
+func rateLimiterMiddleware(r *rateLimiter) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc(
+		"TerraformProviderRateLimiter",
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (out middleware.FinalizeOutput, metadata middleware.Metadata, err error) {
+			if err := r.acquire(ctx); err != nil {
+				return out, metadata, err
+			}
+			defer r.release()
+
+			return next.HandleFinalize(ctx, in)
+		},
+	)
+}
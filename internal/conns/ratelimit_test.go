@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	rateLimiters := newRateLimiters(map[string]RateLimit{
+		"iam":           {MaxConcurrency: 2},
+		"organizations": {RequestsPerSecond: 4},
+		"route53":       {},
+	})
+
+	if got, expected := len(rateLimiters), 2; got != expected {
+		t.Fatalf("incorrect length. Expected: %d, got: %d", expected, got)
+	}
+
+	if r := rateLimiters["iam"]; r.semaphore == nil || cap(r.semaphore) != 2 || r.interval != 0 {
+		t.Errorf("incorrect iam rate limiter: %+v", r)
+	}
+
+	if r := rateLimiters["organizations"]; r.semaphore != nil || r.interval != 250*time.Millisecond {
+		t.Errorf("incorrect organizations rate limiter: %+v", r)
+	}
+}
+
+func TestRateLimiterMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := newRateLimiter(RateLimit{MaxConcurrency: 2})
+
+	var wg sync.WaitGroup
+	var current, peak atomic.Int32
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := r.acquire(ctx); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer r.release()
+
+			n := current.Add(1)
+			for {
+				if v := peak.Load(); n <= v || peak.CompareAndSwap(v, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			current.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	if got, expected := peak.Load(), int32(2); got != expected {
+		t.Errorf("incorrect peak concurrency. Expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestRateLimiterRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := newRateLimiter(RateLimit{RequestsPerSecond: 50})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := r.acquire(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		r.release()
+	}
+
+	// The first request is sent immediately, the remaining 4 are spaced 20ms apart.
+	if got, expected := time.Since(start), 80*time.Millisecond; got < expected {
+		t.Errorf("requests sent too quickly. Expected at least: %s, got: %s", expected, got)
+	}
+}
+
+func TestRateLimiterContextDone(t *testing.T) {
+	t.Parallel()
+
+	r := newRateLimiter(RateLimit{MaxConcurrency: 1})
+
+	if err := r.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := r.acquire(ctx); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type (
+	regionContextKeyType int
+)
+
+var (
+	regionContextKey regionContextKeyType
+	regionRegexp     = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+// NewRegionContext returns a Context in which AWS API clients are configured for the specified AWS Region.
+// It is used to implement the per-resource `region` argument.
+func NewRegionContext(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionContextKey, region)
+}
+
+// RegionFromContext returns the AWS Region kept in Context, if any.
+func RegionFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(regionContextKey).(string)
+	return v, ok && v != ""
+}
+
+// ForRegion returns an AWSClient for the specified AWS Region.
+// Clients for Regions other than the provider's configured Region are created lazily and cached.
+// Their AWS API clients share the provider's credentials, endpoint overrides and rate limits.
+func (c *AWSClient) ForRegion(_ context.Context, region string) *AWSClient {
+	if region == "" || region == c.Region {
+		return c
+	}
+
+	c.regionalLock.Lock()
+	defer c.regionalLock.Unlock()
+
+	if v, ok := c.regionalClients[region]; ok {
+		return v
+	}
+
+	client := &AWSClient{
+		AccountID:         c.AccountID,
+		DefaultTagsConfig: c.DefaultTagsConfig,
+		IgnoreTagsConfig:  c.IgnoreTagsConfig,
+		Partition:         c.Partition,
+		Region:            region,
+		ServicePackages:   c.ServicePackages,
+
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		dnsSuffix:                 c.dnsSuffix,
+		endpoints:                 c.endpoints,
+		httpClient:                c.httpClient,
+		logger:                    c.logger,
+		permissionsPreflightMode:  c.permissionsPreflightMode,
+		rateLimiters:              c.rateLimiters,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		stsRegion:                 c.stsRegion,
+		tagPolicyComplianceMode:   c.tagPolicyComplianceMode,
+	}
+
+	if c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		client.awsConfig = &cfg
+	}
+	if c.session != nil {
+		client.session = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	return client
+}
+
+// forContext returns the AWSClient for any AWS Region kept in Context.
+func (c *AWSClient) forContext(ctx context.Context) *AWSClient {
+	if region, ok := RegionFromContext(ctx); ok {
+		return c.ForRegion(ctx, region)
+	}
+
+	return c
+}
+
+// RegionalImportID splits an import ID of the form `ID@REGION` into its ID and Region parts.
+// For a resource identity object the Region is the value of any `region` attribute and the import ID is unchanged.
+// The Region is empty if the import ID does not specify one.
+func RegionalImportID(id string) (string, string, error) {
+	if IsImportIdentity(id) {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(id), &m); err != nil {
+			// Reported when the identity is parsed.
+			return id, "", nil
+		}
+
+		region, _ := m[names.AttrRegion].(string)
+
+		return id, region, nil
+	}
+
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return id, "", nil
+	}
+
+	// An `@` not followed by a Region is part of the ID, e.g. an email address.
+	if region := id[i+1:]; regionRegexp.MatchString(region) {
+		if i == 0 {
+			return "", "", fmt.Errorf("unexpected format of import ID (%s), expected ID@REGION", id)
+		}
+
+		return id[:i], region, nil
+	}
+
+	return id, "", nil
+}
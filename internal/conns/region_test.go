@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegionalImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id             string
+		expectedID     string
+		expectedRegion string
+		expectedErr    bool
+	}{
+		"empty": {},
+		"no Region": {
+			id:         "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		"Region": {
+			id:             "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"Region and composite ID": {
+			id:             "A1,DRAFT,K1@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "A1,DRAFT,K1",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		"email address": {
+			id:         "user@example.com",
+			expectedID: "user@example.com",
+		},
+		"email address and Region": {
+			id:             "user@example.com@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"only Region": {
+			id:          "@eu-west-1", //lintignore:AWSAT003
+			expectedErr: true,
+		},
+		"identity": {
+			id:         `{"name":"example"}`,
+			expectedID: `{"name":"example"}`,
+		},
+		"identity with Region": {
+			id:             `{"name":"example","region":"eu-west-1"}`, //lintignore:AWSAT003
+			expectedID:     `{"name":"example","region":"eu-west-1"}`, //lintignore:AWSAT003
+			expectedRegion: "eu-west-1",                               //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, err := RegionalImportID(testCase.id)
+
+			if got, expected := err != nil, testCase.expectedErr; got != expected {
+				t.Fatalf("incorrect error. Expected: %t, got: %t (%v)", expected, got, err)
+			}
+
+			if got, expected := id, testCase.expectedID; got != expected {
+				t.Errorf("incorrect ID. Expected: %s, got: %s", expected, got)
+			}
+
+			if got, expected := region, testCase.expectedRegion; got != expected {
+				t.Errorf("incorrect Region. Expected: %s, got: %s", expected, got)
+			}
+		})
+	}
+}
+
+func TestForRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	if got := client.ForRegion(ctx, ""); got != client {
+		t.Errorf("expected provider client for empty Region")
+	}
+
+	if got := client.ForRegion(ctx, "us-west-2"); got != client { //lintignore:AWSAT003
+		t.Errorf("expected provider client for provider Region")
+	}
+
+	regional := client.ForRegion(ctx, "eu-west-1") //lintignore:AWSAT003
+
+	if got, expected := regional.Region, "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("incorrect Region. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := regional.AccountID, client.AccountID; got != expected {
+		t.Errorf("incorrect account ID. Expected: %s, got: %s", expected, got)
+	}
+
+	if got := client.ForRegion(ctx, "eu-west-1"); got != regional { //lintignore:AWSAT003
+		t.Errorf("expected cached client for Region")
+	}
+
+	if got := client.forContext(NewRegionContext(ctx, "eu-west-1")); got != regional { //lintignore:AWSAT003
+		t.Errorf("expected cached client for Region in Context")
+	}
+
+	if got := client.forContext(ctx); got != client {
+		t.Errorf("expected provider client for no Region in Context")
+	}
+}
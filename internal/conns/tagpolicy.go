@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Tag policy compliance modes.
+const (
+	TagPolicyComplianceModeError   = "error"
+	TagPolicyComplianceModeWarning = "warning"
+)
+
+func TagPolicyComplianceMode_Values() []string {
+	return []string{
+		TagPolicyComplianceModeError,
+		TagPolicyComplianceModeWarning,
+	}
+}
+
+// TagPolicyComplianceMode returns the configured tag policy compliance mode.
+// An empty string indicates that planned tags are not validated against the effective tag policy.
+func (c *AWSClient) TagPolicyComplianceMode() string {
+	return c.tagPolicyComplianceMode
+}
+
+// TagPolicyViolations returns the specified resource tags' violations of the account's effective AWS Organizations tag policy.
+// The resource is identified from the Context, see NewTagPolicyContext.
+// The effective tag policy is fetched once per provider configuration.
+func (c *AWSClient) TagPolicyViolations(ctx context.Context, tags tftags.KeyValueTags) ([]tftags.PolicyViolation, error) {
+	if c.tagPolicyComplianceMode == "" {
+		return nil, nil
+	}
+
+	policy, err := c.loadTagPolicy(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	typeName, _ := ctx.Value(tagPolicyTypeNameContextKey).(string)
+
+	return policy.Validate(tags, typeName), nil
+}
+
+type tagPolicyTypeNameContextKeyType int
+
+var tagPolicyTypeNameContextKey tagPolicyTypeNameContextKeyType
+
+// NewTagPolicyContext returns a Context that identifies, by its Terraform type name, the resource whose tags are validated by TagPolicyViolations.
+func NewTagPolicyContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, tagPolicyTypeNameContextKey, typeName)
+}
+
+// loadTagPolicy returns the account's effective tag policy, fetching it if it has not already been fetched.
+// Errors are not cached so that a transient failure is retried on the next call.
+func (c *AWSClient) loadTagPolicy(ctx context.Context) (*tftags.Policy, error) {
+	c.tagPolicyLock.Lock()
+	defer c.tagPolicyLock.Unlock()
+
+	if !c.tagPolicyLoaded {
+		policy, err := c.effectiveTagPolicy(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		c.tagPolicy, c.tagPolicyLoaded = policy, true
+	}
+
+	return c.tagPolicy, nil
+}
+
+// effectiveTagPolicy returns the account's effective tag policy.
+// A nil policy is returned if the account is not a member of an organization or no tag policy applies to it.
+func (c *AWSClient) effectiveTagPolicy(ctx context.Context) (*tftags.Policy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: awstypes.EffectivePolicyTypeTagPolicy,
+	}
+
+	output, err := c.OrganizationsClient(ctx).DescribeEffectivePolicy(ctx, input)
+
+	if errs.IsA[*awstypes.AWSOrganizationsNotInUseException](err) || errs.IsA[*awstypes.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Organizations effective tag policy: %w", err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil || output.EffectivePolicy.PolicyContent == nil {
+		return nil, nil
+	}
+
+	return tftags.ParsePolicy(*output.EffectivePolicy.PolicyContent)
+}
@@ -86,6 +86,11 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(Union); ok && vTo.Kind() == reflect.Interface {
+		diags.Append(expandUnion(ctx, fromUnion, valFrom, vTo, expander)...)
+		return diags
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", valFrom.Kind()))
@@ -972,6 +977,92 @@ func expandExpander(ctx context.Context, fromExpander Expander, toVal reflect.Va
 	return diags
 }
 
+// expandUnion copies the single set field of a Plugin Framework Union to the corresponding member of an AWS API tagged union.
+func expandUnion(ctx context.Context, fromUnion Union, valFrom, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if valFrom.Kind() == reflect.Ptr {
+		valFrom = valFrom.Elem()
+	}
+
+	var (
+		typMember reflect.Type
+		memberSet reflect.StructField
+	)
+	opts := flexer.getOptions()
+	for _, member := range fromUnion.UnionMembers() {
+		typ := reflect.TypeOf(member)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		field, ok := unionMemberField(valFrom, typ, opts)
+		if !ok {
+			diags.Append(diagUnionMemberNotFound(typ, valFrom.Type()))
+			return diags
+		}
+
+		if !isUnionMemberSet(valFrom.FieldByIndex(field.Index)) {
+			continue
+		}
+
+		if typMember != nil {
+			diags.Append(diagConflictingUnionMembers(valFrom.Type(), memberSet.Name, field.Name))
+			return diags
+		}
+
+		typMember, memberSet = typ, field
+	}
+
+	// No need to set the target value if no member is set.
+	if typMember == nil {
+		return diags
+	}
+
+	to := reflect.New(typMember)
+	toFieldVal := to.Elem().FieldByName("Value")
+	if !toFieldVal.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member %s has no Value field", fullTypeName(typMember)))
+		return diags
+	}
+
+	diags.Append(flexer.convert(ctx, valFrom.FieldByIndex(memberSet.Index), toFieldVal)...)
+	if diags.HasError() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", memberSet.Name))
+		return diags
+	}
+
+	// AWS SDK for Go v2 union members implement the union interface with pointer receivers.
+	targetType := valTo.Type()
+	switch {
+	case to.Type().Implements(targetType):
+		valTo.Set(to)
+
+	case typMember.Implements(targetType):
+		valTo.Set(to.Elem())
+
+	default:
+		diags.Append(diagExpandedTypeDoesNotImplement(to.Type(), targetType))
+	}
+
+	return diags
+}
+
+// isUnionMemberSet returns whether a Plugin Framework Union field has a configured value.
+// Empty collections, such as unconfigured list blocks, are not considered set.
+func isUnionMemberSet(val reflect.Value) bool {
+	v, ok := val.Interface().(attr.Value)
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(interface{ Elements() []attr.Value }); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
 func diagExpandsToNil(expanderType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -1001,3 +1092,23 @@ func diagCannotBeAssigned(expandedType, targetType reflect.Type) diag.ErrorDiagn
 			fmt.Sprintf("Type %q cannot be assigned to %q.", fullTypeName(expandedType), fullTypeName(targetType)),
 	)
 }
+
+func diagUnionMemberNotFound(memberType, unionType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union member %q has no corresponding field in %q.", fullTypeName(memberType), fullTypeName(unionType)),
+	)
+}
+
+func diagConflictingUnionMembers(unionType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Fields %q and %q of union %q are both set.", fieldName1, fieldName2, fullTypeName(unionType)),
+	)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func TestExpand(t *testing.T) {
@@ -1233,6 +1234,111 @@ func TestExpandOptions(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandStructTags(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Name        types.String      `tfsdk:"name" autoflex:"DisplayName"`
+		Description types.String      `tfsdk:"description" autoflex:"-"`
+		Status      types.String      `tfsdk:"status" autoflex:",omitexpand"`
+		Type        types.String      `tfsdk:"type" autoflex:",omitflatten"`
+		CreatedAt   timetypes.RFC3339 `tfsdk:"created_at" autoflex:",converter=epochseconds"`
+		UpdatedAt   timetypes.RFC3339 `tfsdk:"updated_at" autoflex:"LastUpdated,converter=epochseconds"`
+	}
+	type aws01 struct {
+		Name        *string
+		DisplayName *string
+		Description *string
+		Status      *string
+		Type        *string
+		CreatedAt   *int64
+		LastUpdated int32
+	}
+	type tf02 struct {
+		Document types.String `tfsdk:"document" autoflex:",converter=document"`
+	}
+	type aws02 struct {
+		Document smithyjson.JSONStringer
+	}
+	type tf03 struct {
+		Field1 types.String `tfsdk:"field1" autoflex:",converter=unknown"`
+	}
+	type tf04 struct {
+		Field1 types.String `tfsdk:"field1" autoflex:",omitempty"`
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "tagged fields",
+			Source: &tf01{
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("description"),
+				Status:      types.StringValue("status"),
+				Type:        types.StringValue("type"),
+				CreatedAt:   timetypes.NewRFC3339ValueMust("2013-09-25T09:34:01Z"),
+				UpdatedAt:   timetypes.NewRFC3339ValueMust("2013-09-26T09:34:01Z"),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				DisplayName: aws.String("name"),
+				Type:        aws.String("type"),
+				CreatedAt:   aws.Int64(1380101641),
+				LastUpdated: 1380188041,
+			},
+		},
+		{
+			TestName: "null converter Source",
+			Source: &tf01{
+				CreatedAt: timetypes.NewRFC3339Null(),
+				UpdatedAt: timetypes.NewRFC3339Unknown(),
+			},
+			Target:     &aws01{},
+			WantTarget: &aws01{},
+		},
+		{
+			TestName: "custom converter",
+			Options: []AutoFlexOptionsFunc{
+				WithConverter("document", JSONDocumentConverter(newTestJSONDocument)),
+			},
+			Source: &tf02{
+				Document: types.StringValue(`{"field1": "a"}`),
+			},
+			Target: &aws02{},
+			WantTarget: &aws02{
+				Document: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+		},
+		{
+			TestName: "unknown converter",
+			Source: &tf03{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &TestFlexAWS01{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("AutoFlEx", `no "unknown" converter for expand`),
+				diag.NewErrorDiagnostic("AutoFlEx", "convert (Field1)"),
+				diag.NewErrorDiagnostic("AutoFlEx", "Expand[*flex.tf03, *flex.TestFlexAWS01]"),
+			},
+		},
+		{
+			TestName: "unsupported tag option",
+			Source: &tf04{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &TestFlexAWS01{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("AutoFlEx", `field Field1: unsupported autoflex tag option "omitempty"`),
+				diag.NewErrorDiagnostic("AutoFlEx", "Expand[*flex.tf04, *flex.TestFlexAWS01]"),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -1403,6 +1509,128 @@ func TestExpandInterface(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var targetUnion testFlexAWSUnion
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "top level",
+			Source: testFlexTFUnion{
+				StringValue: types.StringValue("value1"),
+				StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			},
+			Target: &targetUnion,
+			WantTarget: testFlexAWSUnionPtr(&testFlexAWSUnionMemberStringValue{
+				Value: "value1",
+			}),
+		},
+		{
+			TestName: "top level conflicting members",
+			Source: testFlexTFUnion{
+				StringValue: types.StringValue("value1"),
+				StructValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+					Field1: types.StringValue("value2"),
+				}),
+			},
+			Target: &targetUnion,
+			expectedDiags: diag.Diagnostics{
+				diagConflictingUnionMembers(reflect.TypeFor[testFlexTFUnion](), "StringValue", "StructValue"),
+				diag.NewErrorDiagnostic("AutoFlEx", "Expand[flex.testFlexTFUnion, *flex.testFlexAWSUnion]"),
+			},
+		},
+		{
+			TestName: "single list Source and single union Target",
+			Source: testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+							Field1: types.StringValue("value1"),
+						}),
+					},
+				}),
+			},
+			Target: &testFlexAWSUnionSingle{},
+			WantTarget: &testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStructValue{
+					Value: TestFlexAWS01{
+						Field1: "value1",
+					},
+				},
+			},
+		},
+		{
+			TestName: "single list Source with no members and single union Target",
+			Source: testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTF01{}),
+					},
+				}),
+			},
+			Target: &testFlexAWSUnionSingle{},
+			WantTarget: &testFlexAWSUnionSingle{
+				Field1: nil,
+			},
+		},
+		{
+			TestName: "non-empty list Source and non-empty union Target",
+			Source: testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+							Field1: types.StringValue("value2"),
+						}),
+					},
+				}),
+			},
+			Target: &testFlexAWSUnionSlice{},
+			WantTarget: &testFlexAWSUnionSlice{
+				Field1: []testFlexAWSUnion{
+					&testFlexAWSUnionMemberStringValue{
+						Value: "value1",
+					},
+					&testFlexAWSUnionMemberStructValue{
+						Value: TestFlexAWS01{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+		},
+		{
+			TestName: "object value Source and single union Target",
+			Source: testFlexTFUnionObjectValue{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &testFlexTFUnion{
+					StringValue: types.StringValue("value1"),
+					StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+			Target: &testFlexAWSUnionSingle{},
+			WantTarget: &testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func testFlexAWSUnionPtr(v testFlexAWSUnion) *testFlexAWSUnion { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
 func testFlexAWSInterfaceInterfacePtr(v testFlexAWSInterfaceInterface) *testFlexAWSInterfaceInterface { // nosemgrep:ci.aws-in-func-name
 	return &v
 }
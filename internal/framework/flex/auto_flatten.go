@@ -872,6 +872,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	if _, ok := to.(Union); ok {
+		//
+		// interface -> Union.
+		//
+		diags.Append(autoFlexConvertStruct(ctx, vFrom.Interface(), to, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
@@ -1122,6 +1141,42 @@ func flattenFlattener(ctx context.Context, fromVal reflect.Value, toFlattener Fl
 	return diags
 }
 
+// flattenUnion copies an AWS API tagged union member to the corresponding field of a Plugin Framework Union.
+// The Union's other fields are set to null.
+func flattenUnion(ctx context.Context, valFrom, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typFrom := valFrom.Type()
+	field, ok := unionMemberField(valTo, typFrom, flexer.getOptions())
+	if !ok {
+		// For example, types.UnknownUnionMember returned by a newer API version.
+		tflog.Info(ctx, "AutoFlex Flatten; unknown union member", map[string]any{
+			"from": fullTypeName(typFrom),
+			"to":   fullTypeName(valTo.Type()),
+		})
+		return diags
+	}
+
+	fromFieldVal := valFrom.FieldByName("Value")
+	if !fromFieldVal.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member %s has no Value field", fullTypeName(typFrom)))
+		return diags
+	}
+
+	diags.Append(flexer.convert(ctx, fromFieldVal, valTo.FieldByIndex(field.Index))...)
+	if diags.HasError() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", field.Name))
+		return diags
+	}
+
+	return diags
+}
+
 func flattenPrePopulate(ctx context.Context, toVal reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func TestFlatten(t *testing.T) {
@@ -1212,6 +1213,96 @@ func TestFlattenOptions(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenStructTags(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Name        types.String      `tfsdk:"name" autoflex:"DisplayName"`
+		Description types.String      `tfsdk:"description" autoflex:"-"`
+		Status      types.String      `tfsdk:"status" autoflex:",omitexpand"`
+		Type        types.String      `tfsdk:"type" autoflex:",omitflatten"`
+		CreatedAt   timetypes.RFC3339 `tfsdk:"created_at" autoflex:",converter=epochseconds"`
+		UpdatedAt   types.String      `tfsdk:"updated_at" autoflex:"LastUpdated,converter=epochseconds"`
+	}
+	type aws01 struct {
+		Name        *string
+		DisplayName *string
+		Description *string
+		Status      *string
+		Type        *string
+		CreatedAt   *int64
+		LastUpdated int32
+	}
+	type tf02 struct {
+		Document types.String `tfsdk:"document" autoflex:",converter=document"`
+	}
+	type aws02 struct {
+		Document smithyjson.JSONStringer
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "tagged fields",
+			Source: &aws01{
+				Name:        aws.String("name"),
+				DisplayName: aws.String("display name"),
+				Description: aws.String("description"),
+				Status:      aws.String("status"),
+				Type:        aws.String("type"),
+				CreatedAt:   aws.Int64(1380101641),
+				LastUpdated: 1380188041,
+			},
+			Target: &tf01{},
+			WantTarget: &tf01{
+				Name:      types.StringValue("display name"),
+				Status:    types.StringValue("status"),
+				CreatedAt: timetypes.NewRFC3339ValueMust("2013-09-25T09:34:01Z"),
+				UpdatedAt: types.StringValue("2013-09-26T09:34:01Z"),
+			},
+		},
+		{
+			TestName: "nil converter Source",
+			Source:   &aws01{},
+			Target:   &tf01{},
+			WantTarget: &tf01{
+				Name:      types.StringNull(),
+				Status:    types.StringNull(),
+				CreatedAt: timetypes.NewRFC3339Null(),
+				UpdatedAt: types.StringValue("1970-01-01T00:00:00Z"),
+			},
+		},
+		{
+			TestName: "custom converter",
+			Options: []AutoFlexOptionsFunc{
+				WithConverter("document", JSONDocumentConverter(newTestJSONDocument)),
+			},
+			Source: &aws02{
+				Document: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+			Target: &tf02{},
+			WantTarget: &tf02{
+				Document: types.StringValue(`{"field1":"a"}`),
+			},
+		},
+		{
+			TestName: "nil custom converter Source",
+			Options: []AutoFlexOptionsFunc{
+				WithConverter("document", JSONDocumentConverter(newTestJSONDocument)),
+			},
+			Source: &aws02{},
+			Target: &tf02{},
+			WantTarget: &tf02{
+				Document: types.StringNull(),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenInterface(t *testing.T) {
 	t.Parallel()
 
@@ -1426,6 +1517,135 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "nil union Source and list Target",
+			Source: testFlexAWSUnionSingle{
+				Field1: nil,
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[testFlexTFUnion](ctx),
+			},
+		},
+		{
+			TestName: "string member Source and single list Target",
+			Source: testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					},
+				}),
+			},
+		},
+		{
+			TestName: "struct member Source and single list Target",
+			Source: testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStructValue{
+					Value: TestFlexAWS01{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+							Field1: types.StringValue("value1"),
+						}),
+					},
+				}),
+			},
+		},
+		{
+			TestName: "unknown member Source and single list Target",
+			Source: testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberUnknown{
+					Value: "value1",
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				{
+					"@level":   "info",
+					"@module":  "provider",
+					"@message": "AutoFlex Flatten; unknown union member",
+					"from":     "github.com/hashicorp/terraform-provider-aws/internal/framework/flex.testFlexAWSUnionMemberUnknown",
+					"to":       "github.com/hashicorp/terraform-provider-aws/internal/framework/flex.testFlexTFUnion",
+				},
+			},
+		},
+		{
+			TestName: "non-empty union Source and non-empty list Target",
+			Source: testFlexAWSUnionSlice{
+				Field1: []testFlexAWSUnion{
+					&testFlexAWSUnionMemberStringValue{
+						Value: "value1",
+					},
+					&testFlexAWSUnionMemberStructValue{
+						Value: TestFlexAWS01{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						StructValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+							Field1: types.StringValue("value2"),
+						}),
+					},
+				}),
+			},
+		},
+		{
+			TestName: "string member Source and object value Target",
+			Source: testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &testFlexTFUnionObjectValue{},
+			WantTarget: &testFlexTFUnionObjectValue{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &testFlexTFUnion{
+					StringValue: types.StringValue("value1"),
+					StructValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				}),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
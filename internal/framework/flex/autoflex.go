@@ -37,6 +37,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// converters stores named Converters, in addition to the built-in ones,
+	// that can be referenced from `autoflex` struct tags
+	converters map[string]Converter
 }
 
 // IsIgnoredField returns true if s is in the list of ignored field names
@@ -62,6 +66,25 @@ func (o *AutoFlexOptions) SetIgnoredFields(fields []string) {
 	o.ignoredFieldNames = fields
 }
 
+// AddConverter registers converter under name for use by `autoflex` struct tags,
+// replacing any built-in converter with the same name
+func (o *AutoFlexOptions) AddConverter(name string, converter Converter) {
+	if o.converters == nil {
+		o.converters = make(map[string]Converter)
+	}
+	o.converters[name] = converter
+}
+
+// converter returns the named converter
+func (o *AutoFlexOptions) converter(name string) (Converter, bool) {
+	if converter, ok := o.converters[name]; ok {
+		return converter, true
+	}
+
+	converter, ok := builtinConverters[name]
+	return converter, ok
+}
+
 var (
 	DefaultIgnoredFieldNames = []string{
 		"Tags", // Resource tags are handled separately.
@@ -71,6 +94,80 @@ var (
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(*AutoFlexOptions)
 
+// WithConverter is an autoFlexer functional option that registers a named Converter.
+func WithConverter(name string, converter Converter) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.AddConverter(name, converter)
+	}
+}
+
+const autoFlexTagName = "autoflex"
+
+// autoFlexTag stores the options of a Plugin Framework data model field's `autoflex` struct tag.
+//
+// The tag's value is the name of the corresponding AWS API field, which may be empty,
+// followed by comma-separated options:
+//
+//	`autoflex:"-"`                        the field is neither expanded nor flattened
+//	`autoflex:"DisplayName"`              the field corresponds to the AWS API field DisplayName
+//	`autoflex:",omitexpand"`              the field is not expanded
+//	`autoflex:",omitflatten"`             the field is not flattened
+//	`autoflex:",converter=epochseconds"`  the field is converted by the named Converter
+type autoFlexTag struct {
+	name        string
+	omit        bool
+	omitExpand  bool
+	omitFlatten bool
+	converter   string
+}
+
+// parseAutoFlexTag parses the `autoflex` struct tag of the specified field.
+func parseAutoFlexTag(field reflect.StructField) (autoFlexTag, error) {
+	var tag autoFlexTag
+
+	v, ok := field.Tag.Lookup(autoFlexTagName)
+	if !ok {
+		return tag, nil
+	}
+
+	if v == "-" {
+		tag.omit = true
+		return tag, nil
+	}
+
+	name, options, _ := strings.Cut(v, ",")
+	tag.name = name
+
+	for _, option := range strings.Split(options, ",") {
+		switch k, v, _ := strings.Cut(option, "="); k {
+		case "":
+		case "omitexpand":
+			tag.omitExpand = true
+		case "omitflatten":
+			tag.omitFlatten = true
+		case "converter":
+			if v == "" {
+				return tag, fmt.Errorf("field %s: empty %s converter name", field.Name, autoFlexTagName)
+			}
+			tag.converter = v
+		default:
+			return tag, fmt.Errorf("field %s: unsupported %s tag option %q", field.Name, autoFlexTagName, option)
+		}
+	}
+
+	return tag, nil
+}
+
+// Union is implemented by types that represent an AWS API tagged union.
+// An AWS SDK for Go v2 tagged union is an interface type implemented by one
+// `<Union>Member<Name>` struct per member, each with a single `Value` field.
+// Each exported field of a Union corresponds to the member whose name ends in
+// `Member<FieldName>` and at most one of the fields may be set.
+type Union interface {
+	// UnionMembers returns a zero value of each of the union's member types.
+	UnionMembers() []any
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -143,6 +240,11 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if fromUnion, ok := valFrom.Interface().(Union); ok {
+			diags.Append(expandUnion(ctx, fromUnion, valFrom, valTo, flexer)...)
+			return diags
+		}
+
 		tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -155,6 +257,11 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		return diags
 	}
 
+	if _, ok := to.(Union); ok {
+		diags.Append(flattenUnion(ctx, valFrom, valTo, flexer)...)
+		return diags
+	}
+
 	opts := flexer.getOptions()
 	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
 		field := typFrom.Field(i)
@@ -169,15 +276,44 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			continue
 		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
-		if !toFieldVal.IsValid() {
+		// Only the Plugin Framework data model's fields have `autoflex` tags,
+		// so a tagged "from" field is being expanded and a tagged "to" field is being flattened.
+		tagFrom, err := parseAutoFlexTag(field)
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+		if tagFrom.omit || tagFrom.omitExpand {
+			continue
+		}
+
+		fieldTo, ok := findField(ctx, field, tagFrom, valTo, valFrom, flexer)
+		if !ok {
 			continue // Corresponding field not found in to.
 		}
+		tagTo, err := parseAutoFlexTag(fieldTo)
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+		if tagTo.omit || tagTo.omitFlatten {
+			continue
+		}
+		toFieldVal := valTo.FieldByIndex(fieldTo.Index)
 		if !toFieldVal.CanSet() {
 			continue // Corresponding field value can't be changed.
 		}
 
-		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
+		switch {
+		case tagFrom.converter != "":
+			diags.Append(expandConverter(ctx, tagFrom.converter, opts, valFrom.Field(i), toFieldVal)...)
+
+		case tagTo.converter != "":
+			diags.Append(flattenConverter(ctx, tagTo.converter, opts, valFrom.Field(i), toFieldVal)...)
+
+		default:
+			diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
+		}
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
 			return diags
@@ -197,10 +333,12 @@ func fullTypeName(t reflect.Type) string {
 	return t.Name()
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) reflect.Value {
+func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) (reflect.StructField, bool) {
+	typTo := valTo.Type()
+
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
-		return v
+	if field, ok := fieldByNameUntagged(typTo, fieldNameFrom); ok {
+		return field, true
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -211,7 +349,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 
 	// second precedence is exact match (case insensitive)
 	opts := flexer.getOptions()
-	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+	for i := 0; i < typTo.NumField(); i++ {
 		field := typTo.Field(i)
 		if field.PkgPath != "" {
 			continue // Skip unexported fields.
@@ -220,22 +358,21 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if opts.IsIgnoredField(fieldNameTo) {
 			continue
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return v
+		if field, ok := fieldByNameUntagged(typTo, fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+			return field, true
 		}
 	}
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
-			return v
+		if field, ok := fieldByNameUntagged(typTo, plural.Plural(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
-			return v
+		if field, ok := fieldByNameUntagged(typTo, plural.Singular(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
@@ -252,8 +389,78 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		}
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	// no finds, fuzzy or otherwise
+	return reflect.StructField{}, false
+}
+
+// fieldByNameUntagged returns the field of struct type `typ` with the specified name.
+// Fields whose `autoflex` tag maps them to a differently named field are only matched by that name.
+func fieldByNameUntagged(typ reflect.Type, name string) (reflect.StructField, bool) {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return field, false
+	}
+
+	if tag, err := parseAutoFlexTag(field); err == nil && tag.name != "" && tag.name != name {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+// findField returns the field of struct `valTo` corresponding to field `fieldFrom` of struct `valFrom`.
+// A field's `autoflex` tag name takes precedence over fuzzy matching by field name.
+func findField(ctx context.Context, fieldFrom reflect.StructField, tagFrom autoFlexTag, valTo, valFrom reflect.Value, flexer autoFlexer) (reflect.StructField, bool) {
+	typTo := valTo.Type()
+
+	// A "from" field with a tag name only matches the named "to" field.
+	if tagFrom.name != "" {
+		return typTo.FieldByName(tagFrom.name)
+	}
+
+	// A "to" field with a tag name only matches the named "from" field.
+	for i := 0; i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if tag, err := parseAutoFlexTag(field); err == nil && tag.name == fieldFrom.Name {
+			return field, true
+		}
+	}
+
+	return findFieldFuzzy(ctx, fieldFrom.Name, valTo, valFrom, flexer)
+}
+
+// unionMemberField returns the field of struct `valUnion` that corresponds to the union member type `typMember`.
+// If several field names match the member type name, the longest one is used.
+func unionMemberField(valUnion reflect.Value, typMember reflect.Type, opts AutoFlexOptions) (reflect.StructField, bool) {
+	var (
+		found  reflect.StructField
+		ok     bool
+		member = typMember.Name()
+	)
+
+	for i, typUnion := 0, valUnion.Type(); i < typUnion.NumField(); i++ {
+		field := typUnion.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if opts.IsIgnoredField(field.Name) {
+			continue
+		}
+
+		suffix := "Member" + field.Name
+		if n := len(member) - len(suffix); n < 0 || !strings.EqualFold(member[n:], suffix) {
+			continue
+		}
+
+		if !ok || len(field.Name) > len(found.Name) {
+			found, ok = field, true
+		}
+	}
+
+	return found, ok
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
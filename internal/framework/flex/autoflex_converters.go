@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	smithydocument "github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// Converter converts a single field value between a resource's data structure
+// and an AWS API data structure.
+// Converters are referenced by name from a field's `autoflex` struct tag, e.g.
+//
+//	CreatedAt timetypes.RFC3339 `tfsdk:"created_at" autoflex:",converter=epochseconds"`
+type Converter struct {
+	// Expand converts a known, non-null Plugin Framework value to an AWS API value.
+	// Numeric and string results are converted to the AWS API field's type.
+	Expand func(ctx context.Context, from attr.Value) (any, diag.Diagnostics)
+
+	// Flatten converts an AWS API value, which may be nil, to a Plugin Framework value.
+	// The result is converted to the data structure field's type.
+	Flatten func(ctx context.Context, from any) (attr.Value, diag.Diagnostics)
+}
+
+const (
+	// ConverterEpochSeconds converts between an RFC 3339 timestamp string and
+	// an integer number of seconds since the Unix epoch.
+	ConverterEpochSeconds = "epochseconds"
+)
+
+var (
+	builtinConverters = map[string]Converter{
+		ConverterEpochSeconds: {
+			Expand:  expandEpochSeconds,
+			Flatten: flattenEpochSeconds,
+		},
+	}
+)
+
+// JSONDocumentConverter returns a Converter between a JSON string and a
+// [Smithy document](https://smithy.io/2.0/spec/simple-types.html#document).
+// f is the AWS SDK for Go v2 service's document constructor, e.g. `document.NewLazyDocument`.
+func JSONDocumentConverter[T smithydocument.Marshaler](f func(any) T) Converter {
+	return Converter{
+		Expand: func(ctx context.Context, from attr.Value) (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			s, d := stringFromValue(ctx, from)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			doc, err := smithyjson.SmithyDocumentFromString(s, f)
+			if err != nil {
+				diags.AddError("AutoFlEx", err.Error())
+				return nil, diags
+			}
+
+			return doc, diags
+		},
+		Flatten: func(ctx context.Context, from any) (attr.Value, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			if from == nil || reflect.ValueOf(from).Kind() == reflect.Pointer && reflect.ValueOf(from).IsNil() {
+				return types.StringNull(), diags
+			}
+
+			doc, ok := from.(smithydocument.Marshaler)
+			if !ok {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%T is not a Smithy document", from))
+				return nil, diags
+			}
+
+			b, err := doc.MarshalSmithyDocument()
+			if err != nil {
+				diags.AddError("AutoFlEx", err.Error())
+				return nil, diags
+			}
+
+			return types.StringValue(string(b)), diags
+		},
+	}
+}
+
+func expandEpochSeconds(ctx context.Context, from attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, from)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		diags.AddError("AutoFlEx", err.Error())
+		return nil, diags
+	}
+
+	return t.Unix(), diags
+}
+
+func flattenEpochSeconds(_ context.Context, from any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v := reflect.ValueOf(from)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return timetypes.NewRFC3339Null(), diags
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return timetypes.NewRFC3339TimeValue(time.Unix(v.Int(), 0).UTC()), diags
+
+	case reflect.Invalid:
+		return timetypes.NewRFC3339Null(), diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s converter cannot flatten %T", ConverterEpochSeconds, from))
+	return nil, diags
+}
+
+// stringFromValue returns the value of a Plugin Framework String(ish) value.
+func stringFromValue(ctx context.Context, from attr.Value) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := from.(basetypes.StringValuable)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("%s is not a String", from.Type(ctx)))
+		return "", diags
+	}
+
+	s, d := v.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return s.ValueString(), diags
+}
+
+// expandConverter copies a Plugin Framework value to an AWS API value using the named Converter.
+func expandConverter(ctx context.Context, name string, opts AutoFlexOptions, valFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	converter, ok := opts.converter(name)
+	if !ok || converter.Expand == nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("no %q converter for expand", name))
+		return diags
+	}
+
+	vFrom, ok := valFrom.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", valFrom.Kind()))
+		return diags
+	}
+
+	// No need to set the target value if there's no source value.
+	if vFrom.IsNull() || vFrom.IsUnknown() {
+		return diags
+	}
+
+	expanded, d := converter.Expand(ctx, vFrom)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if expanded == nil {
+		return diags
+	}
+
+	if val := reflect.ValueOf(expanded); !setConverted(val, vTo) {
+		diags.Append(diagCannotBeAssigned(val.Type(), vTo.Type()))
+		return diags
+	}
+
+	return diags
+}
+
+// flattenConverter copies an AWS API value to a Plugin Framework value using the named Converter.
+func flattenConverter(ctx context.Context, name string, opts AutoFlexOptions, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	converter, ok := opts.converter(name)
+	if !ok || converter.Flatten == nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("no %q converter for flatten", name))
+		return diags
+	}
+
+	valTo, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+		return diags
+	}
+
+	flattened, d := converter.Flatten(ctx, vFrom.Interface())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Convert the flattened value to the target type via its Terraform value.
+	tTo := valTo.Type(ctx)
+	tfVal := tftypes.NewValue(tTo.TerraformType(ctx), nil)
+	if flattened != nil {
+		v, err := flattened.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+		tfVal = v
+	}
+
+	val, err := tTo.ValueFromTerraform(ctx, tfVal)
+	if err != nil {
+		diags.AddError("AutoFlEx", err.Error())
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+
+	return diags
+}
+
+// setConverted sets vTo to val, converting between numeric or string types and
+// taking or dereferencing a pointer as required.
+// It returns false if val cannot be converted to vTo's type.
+func setConverted(val, vTo reflect.Value) bool {
+	tFrom, tTo := val.Type(), vTo.Type()
+
+	if tFrom.AssignableTo(tTo) {
+		vTo.Set(val)
+		return true
+	}
+
+	if tTo.Kind() == reflect.Pointer {
+		v := reflect.New(tTo.Elem())
+		if setConverted(val, v.Elem()) {
+			vTo.Set(v)
+			return true
+		}
+		return false
+	}
+
+	if tFrom.Kind() == reflect.Pointer {
+		return !val.IsNil() && setConverted(val.Elem(), vTo)
+	}
+
+	if kindClass(tFrom.Kind()) == kindClass(tTo.Kind()) && tFrom.ConvertibleTo(tTo) {
+		vTo.Set(val.Convert(tTo))
+		return true
+	}
+
+	return false
+}
+
+// kindClass groups sized numeric kinds, so that e.g. an int64 can be converted to an int32
+// but not to a string.
+func kindClass(k reflect.Kind) reflect.Kind {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return k
+	}
+}
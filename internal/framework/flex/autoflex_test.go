@@ -442,6 +442,58 @@ var _ testFlexAWSInterfaceInterface = &testFlexAWSInterfaceInterfaceImpl{}
 
 func (t *testFlexAWSInterfaceInterfaceImpl) isTestFlexAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type testFlexTFUnion struct {
+	StringValue types.String                                  `tfsdk:"string_value"`
+	StructValue fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"struct_value"`
+}
+
+var _ Union = testFlexTFUnion{}
+
+func (testFlexTFUnion) UnionMembers() []any {
+	return []any{
+		testFlexAWSUnionMemberStringValue{},
+		testFlexAWSUnionMemberStructValue{},
+	}
+}
+
+type testFlexTFUnionListNestedObject struct {
+	Field1 fwtypes.ListNestedObjectValueOf[testFlexTFUnion] `tfsdk:"field1"`
+}
+
+type testFlexTFUnionObjectValue struct {
+	Field1 fwtypes.ObjectValueOf[testFlexTFUnion] `tfsdk:"field1"`
+}
+
+type testFlexAWSUnionSingle struct {
+	Field1 testFlexAWSUnion
+}
+
+type testFlexAWSUnionSlice struct {
+	Field1 []testFlexAWSUnion
+}
+
+type testFlexAWSUnion interface {
+	isTestFlexAWSUnion()
+}
+
+type testFlexAWSUnionMemberStringValue struct {
+	Value string
+}
+
+func (*testFlexAWSUnionMemberStringValue) isTestFlexAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type testFlexAWSUnionMemberStructValue struct {
+	Value TestFlexAWS01
+}
+
+func (*testFlexAWSUnionMemberStructValue) isTestFlexAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type testFlexAWSUnionMemberUnknown struct {
+	Value string
+}
+
+func (*testFlexAWSUnionMemberUnknown) isTestFlexAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
 type testFlexTFFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
@@ -38,6 +38,11 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
+	// Any service or resource type default tags are in Context.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
+
 	var planTags types.Map
 
 	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
@@ -51,6 +56,12 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			resourceTags := tftags.New(ctx, planTags)
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+			r.checkTagPolicy(ctx, request, response, allTags)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
@@ -60,6 +71,46 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	}
 }
 
+// checkTagPolicy validates new or changed tags against the effective Organizations tag policy.
+// In "error" mode violations for resource types for which the policy is enforced are errors, all other violations are warnings.
+func (r *ResourceWithConfigure) checkTagPolicy(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, allTags tftags.KeyValueTags) {
+	mode := r.Meta().TagPolicyComplianceMode()
+
+	if mode == "" {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		var stateTagsAll types.Map
+
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if tftags.New(ctx, stateTagsAll).Equal(allTags) {
+			return
+		}
+	}
+
+	violations, err := r.Meta().TagPolicyViolations(ctx, allTags)
+
+	if err != nil {
+		response.Diagnostics.AddWarning("Unable to check tag policy compliance", err.Error())
+
+		return
+	}
+
+	for _, v := range violations {
+		if mode == conns.TagPolicyComplianceModeError && v.Enforced {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", v.String())
+		} else {
+			response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag Policy Violation", v.String())
+		}
+	}
+}
+
 func mapHasUnknownElements(m types.Map) bool {
 	for _, v := range m.Elements() {
 		if v.IsUnknown() {
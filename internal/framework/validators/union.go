@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// unionValidator validates that exactly one of an Object's attributes is configured.
+type unionValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator unionValidator) Description(_ context.Context) string {
+	return "exactly one of the union's members must be configured"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator unionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateObject performs the validation.
+func (validator unionValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var names, set []string
+	for name, v := range request.ConfigValue.Attributes() {
+		// Unknown members may or may not be configured.
+		if v.IsUnknown() {
+			return
+		}
+
+		names = append(names, name)
+
+		if v.IsNull() {
+			continue
+		}
+
+		// Unconfigured list and set blocks are empty.
+		if v, ok := v.(interface{ Elements() []attr.Value }); ok && len(v.Elements()) == 0 {
+			continue
+		}
+
+		set = append(set, name)
+	}
+
+	if len(set) != 1 {
+		sort.Strings(names)
+		response.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s: [%s]", validator.Description(ctx), strings.Join(names, ", ")),
+		))
+		return
+	}
+}
+
+// Union returns an object validator which ensures that:
+//
+//   - Exactly one of the object's attributes or blocks is configured.
+//
+// It is intended for nested objects that map to an AWS API tagged union
+// (see flex.Union), where each attribute or block is one union member.
+// Member names are read from the object so need not be listed.
+// The provider adds this validator to resource schemas' nested objects whose data model
+// implements flex.Union, so resources need not add it themselves.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Union() validator.Object {
+	return unionValidator{}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestUnionValidator(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"member1": types.StringType,
+		"member2": types.ListType{ElemType: types.StringType},
+	}
+	newObject := func(member1, member2 attr.Value) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"member1": member1,
+			"member2": member2,
+		})
+	}
+	emptyList := types.ListValueMust(types.StringType, []attr.Value{})
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("value2")})
+	invalidCombination := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Combination",
+			`Exactly one of the union's members must be configured: [member1, member2]`,
+		),
+	}
+
+	type testCase struct {
+		val                 types.Object
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown Object": {
+			val: types.ObjectUnknown(attrTypes),
+		},
+		"null Object": {
+			val: types.ObjectNull(attrTypes),
+		},
+		"no members": {
+			val:                 newObject(types.StringNull(), types.ListNull(types.StringType)),
+			expectedDiagnostics: invalidCombination,
+		},
+		"empty list member": {
+			val:                 newObject(types.StringNull(), emptyList),
+			expectedDiagnostics: invalidCombination,
+		},
+		"string member": {
+			val: newObject(types.StringValue("value1"), emptyList),
+		},
+		"list member": {
+			val: newObject(types.StringNull(), list),
+		},
+		"both members": {
+			val:                 newObject(types.StringValue("value1"), list),
+			expectedDiagnostics: invalidCombination,
+		},
+		"unknown member": {
+			val: newObject(types.StringValue("value1"), types.ListUnknown(types.StringType)),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.ObjectRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.ObjectResponse{}
+			fwvalidators.Union().ValidateObject(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
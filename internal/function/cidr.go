@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// VPC and subnet IPv4 CIDR block size limits.
+	// https://docs.aws.amazon.com/vpc/latest/userguide/vpc-cidr-blocks.html
+	ipv4CIDRMinPrefixLength = 16
+	ipv4CIDRMaxPrefixLength = 28
+)
+
+// parseIPv4CIDRBlock parses an IPv4 CIDR block that is valid for a VPC or subnet.
+func parseIPv4CIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := verify.ValidateIPv4CIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if err := validateIPv4PrefixLength(prefix.Bits()); err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q: %w", cidr, err)
+	}
+
+	return prefix, nil
+}
+
+func validateIPv4PrefixLength(bits int) error {
+	if bits < ipv4CIDRMinPrefixLength || bits > ipv4CIDRMaxPrefixLength {
+		return fmt.Errorf("prefix length must be between /%d and /%d, got /%d", ipv4CIDRMinPrefixLength, ipv4CIDRMaxPrefixLength, bits)
+	}
+
+	return nil
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func uint32ToIPv4(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
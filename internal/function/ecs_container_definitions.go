@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+var _ function.Function = ecsContainerDefinitionsFunction{}
+
+func NewECSContainerDefinitionsFunction() function.Function {
+	return &ecsContainerDefinitionsFunction{}
+}
+
+type ecsContainerDefinitionsFunction struct{}
+
+func (f ecsContainerDefinitionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecs_container_definitions"
+}
+
+func (f ecsContainerDefinitionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ecs_container_definitions Function",
+		MarkdownDescription: "Validates ECS container definitions and renders them as the canonical JSON used by the " +
+			"`container_definitions` argument of the `aws_ecs_task_definition` resource",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "container_definitions",
+				MarkdownDescription: "List of container definition objects, a single container definition object, or either in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ecsContainerDefinitionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	var s string
+
+	if v, ok := arg.UnderlyingValue().(basetypes.StringValue); ok {
+		s = v.ValueString()
+	} else {
+		v, err := jsonValue(arg)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+
+		s = string(b)
+	}
+
+	result, err := tfecs.CanonicalContainerDefinitions(s)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// jsonValue returns the JSON-encodable value of a Terraform value, as the built-in jsonencode function does.
+// Null object attributes are omitted.
+func jsonValue(v attr.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	switch v := v.(type) {
+	case basetypes.DynamicValue:
+		return jsonValue(v.UnderlyingValue())
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('f', -1)), nil
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.ListValue:
+		return jsonValues(v.Elements())
+	case basetypes.SetValue:
+		return jsonValues(v.Elements())
+	case basetypes.TupleValue:
+		return jsonValues(v.Elements())
+	case basetypes.MapValue:
+		return jsonObject(v.Elements())
+	case basetypes.ObjectValue:
+		return jsonObject(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type: %s", v.Type(context.Background()))
+	}
+}
+
+func jsonValues(elements []attr.Value) (any, error) {
+	result := make([]any, 0, len(elements))
+
+	for _, e := range elements {
+		v, err := jsonValue(e)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+func jsonObject(attributes map[string]attr.Value) (any, error) {
+	result := make(map[string]any, len(attributes))
+
+	for k, e := range attributes {
+		if e.IsNull() {
+			continue
+		}
+
+		v, err := jsonValue(e)
+		if err != nil {
+			return nil, err
+		}
+
+		result[k] = v
+	}
+
+	return result, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECSContainerDefinitionsFunction_object(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECSContainerDefinitionsFunctionConfig(`[
+    {
+      name   = "web"
+      image  = "nginx"
+      memory = 512
+      portMappings = [{
+        containerPort = 80
+        protocol      = "tcp"
+      }]
+      environment = [
+        { name = "B", value = "2" },
+        { name = "A", value = "1" },
+      ]
+      command = null
+    }
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx","memory":512,"name":"web","portMappings":[{"containerPort":80}]}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestECSContainerDefinitionsFunction_json(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECSContainerDefinitionsFunctionConfig(`jsonencode({ name = "web", image = "nginx", essential = true })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"essential":true,"image":"nginx","name":"web"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestECSContainerDefinitionsFunction_missingImage(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECSContainerDefinitionsFunctionConfig(`[{ name = "web" }]`),
+				ExpectError: regexache.MustCompile(`image[\s\n]*is[\s\n]*required`),
+			},
+		},
+	})
+}
+
+func TestECSContainerDefinitionsFunction_unknownField(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECSContainerDefinitionsFunctionConfig(`[{ name = "web", image = "nginx", memroy = 512 }]`),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*field[\s\n]*"memroy"`),
+			},
+		},
+	})
+}
+
+func testECSContainerDefinitionsFunctionConfig(arg string) string {
+	return `
+output "test" {
+  value = provider::aws::ecs_container_definitions(` + arg + `)
+}
+`
+}
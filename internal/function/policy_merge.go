@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// IAM policy language versions.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_version.html
+	policyVersion2008 = "2008-10-17"
+	policyVersion2012 = "2012-10-17"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single, normalized policy document containing the union of their statements. " +
+			"Equivalent statements are included once. Non-equivalent statements with the same Sid are an error.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergePolicies returns the union of the statements in the specified IAM policy documents.
+// Elements other than Statement and Version must have the same value in each document in which they are present.
+func mergePolicies(policies []string) (string, error) {
+	merged := make(map[string]any)
+	statements := make([]any, 0)
+
+	for i, policy := range policies {
+		if v := strings.TrimSpace(policy); v == "" || v == "{}" {
+			continue
+		}
+
+		doc, err := decodePolicy(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		for k, v := range doc {
+			switch k {
+			case "Statement":
+				var s []any
+				switch v := v.(type) {
+				case nil:
+					// A null Statement contains no statements.
+				case []any:
+					s = v
+				default:
+					s = []any{v}
+				}
+
+				for _, v := range s {
+					if v == nil {
+						continue
+					}
+
+					statements, err = appendStatement(statements, v)
+					if err != nil {
+						return "", fmt.Errorf("policy %d: %w", i, err)
+					}
+				}
+			case "Version":
+				// Prefer the current policy language version.
+				if w, ok := merged[k]; !ok || w == policyVersion2008 {
+					merged[k] = v
+				}
+			default:
+				if w, ok := merged[k]; ok && !reflect.DeepEqual(w, v) {
+					return "", fmt.Errorf("policy %d: conflicting %s element", i, k)
+				}
+
+				merged[k] = v
+			}
+		}
+	}
+
+	if _, ok := merged["Version"]; !ok {
+		merged["Version"] = policyVersion2012
+	}
+	merged["Statement"] = statements
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(string(b))
+}
+
+func decodePolicy(policy string) (map[string]any, error) {
+	var doc map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(policy)))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("policy is invalid JSON: %w", err)
+	}
+
+	return doc, nil
+}
+
+// appendStatement appends the statement unless an equivalent statement is already present.
+func appendStatement(statements []any, statement any) ([]any, error) {
+	sid := statementSID(statement)
+
+	for _, v := range statements {
+		equivalent, err := statementsEquivalent(v, statement)
+		if err != nil {
+			return nil, err
+		}
+
+		if equivalent {
+			return statements, nil
+		}
+
+		if sid != "" && statementSID(v) == sid {
+			return nil, fmt.Errorf("conflicting statements with Sid %q", sid)
+		}
+	}
+
+	return append(statements, statement), nil
+}
+
+func statementSID(statement any) string {
+	if m, ok := statement.(map[string]any); ok {
+		if v, ok := m["Sid"].(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// statementsEquivalent returns whether two statements are semantically equivalent.
+// Each statement is compared as the only statement in a policy document.
+func statementsEquivalent(s1, s2 any) (bool, error) {
+	p1, err := json.Marshal(map[string]any{"Version": policyVersion2012, "Statement": []any{s1}})
+	if err != nil {
+		return false, err
+	}
+
+	p2, err := json.Marshal(map[string]any{"Version": policyVersion2012, "Statement": []any{s2}})
+	if err != nil {
+		return false, err
+	}
+
+	return verify.PolicyStringsEquivalent(string(p1), string(p2)), nil
+}
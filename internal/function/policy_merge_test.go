@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+	policies := []string{
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":["*"]},{"Sid":"Write","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		``,
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"Write"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyMergeFunctionConfig(policies...),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_nullStatement(t *testing.T) {
+	t.Parallel()
+	policies := []string{
+		`{"Version":"2012-10-17","Statement":null}`,
+		`{"Version":"2012-10-17"}`,
+		`{"Version":"2012-10-17","Statement":[null,{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyMergeFunctionConfig(policies...),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_sidConflict(t *testing.T) {
+	t.Parallel()
+	policies := []string{
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Example","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Example","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyMergeFunctionConfig(policies...),
+				ExpectError: regexache.MustCompile(`conflicting[\s\n]*statements[\s\n]*with[\s\n]*Sid`),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyMergeFunctionConfig("{"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testPolicyMergeFunctionConfig(policies ...string) string {
+	args := make([]string, len(policies))
+	for i, v := range policies {
+		args[i] = fmt.Sprintf("%q", v)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::policy_merge([%[1]s])
+}
+`, strings.Join(args, ", "))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// AWS reserves the first four and the last IPv4 address in each subnet.
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+	subnetReservedAddressesStart = 4
+	subnetReservedAddressesEnd   = 1
+)
+
+var subnetReservedAddressesResultAttrTypes = map[string]attr.Type{
+	"network_address":      types.StringType,
+	"vpc_router_address":   types.StringType,
+	"dns_address":          types.StringType,
+	"future_use_address":   types.StringType,
+	"broadcast_address":    types.StringType,
+	"reserved_addresses":   types.ListType{ElemType: types.StringType},
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.Int64Type,
+}
+
+var _ function.Function = subnetReservedAddressesFunction{}
+
+func NewSubnetReservedAddressesFunction() function.Function {
+	return &subnetReservedAddressesFunction{}
+}
+
+type subnetReservedAddressesFunction struct{}
+
+func (f subnetReservedAddressesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_reserved_addresses"
+}
+
+func (f subnetReservedAddressesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_reserved_addresses Function",
+		MarkdownDescription: "Returns the IPv4 addresses that AWS reserves in a subnet, the first four and the last, " +
+			"along with the range and number of addresses available for use",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "Subnet IPv4 CIDR block, with a prefix length between /16 and /28",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: subnetReservedAddressesResultAttrTypes,
+		},
+	}
+}
+
+func (f subnetReservedAddressesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseIPv4CIDRBlock(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	first := ipv4ToUint32(prefix.Addr())
+	size := uint32(1) << (32 - prefix.Bits())
+	last := first + size - 1
+
+	reserved := []attr.Value{
+		types.StringValue(uint32ToIPv4(first).String()),
+		types.StringValue(uint32ToIPv4(first + 1).String()),
+		types.StringValue(uint32ToIPv4(first + 2).String()),
+		types.StringValue(uint32ToIPv4(first + 3).String()),
+		types.StringValue(uint32ToIPv4(last).String()),
+	}
+
+	reservedAddresses, d := types.ListValue(types.StringType, reserved)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"network_address":      reserved[0],
+		"vpc_router_address":   reserved[1],
+		"dns_address":          reserved[2],
+		"future_use_address":   reserved[3],
+		"broadcast_address":    reserved[4],
+		"reserved_addresses":   reservedAddresses,
+		"first_usable_address": types.StringValue(uint32ToIPv4(first + subnetReservedAddressesStart).String()),
+		"last_usable_address":  types.StringValue(uint32ToIPv4(last - subnetReservedAddressesEnd).String()),
+		"usable_address_count": types.Int64Value(int64(size - subnetReservedAddressesStart - subnetReservedAddressesEnd)),
+	}
+
+	result, d := types.ObjectValue(subnetReservedAddressesResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
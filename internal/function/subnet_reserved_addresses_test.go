@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestSubnetReservedAddressesFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetReservedAddressesFunctionConfig("10.0.0.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("reserved_addresses", "10.0.0.0,10.0.0.1,10.0.0.2,10.0.0.3,10.0.0.255"),
+					resource.TestCheckOutput("first_usable_address", "10.0.0.4"),
+					resource.TestCheckOutput("last_usable_address", "10.0.0.254"),
+					resource.TestCheckOutput("usable_address_count", "251"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetReservedAddressesFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetReservedAddressesFunctionConfig("10.0.0.0/29"),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func TestSubnetReservedAddressesFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetReservedAddressesFunctionConfig("10.0.0.1/24"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv4[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testSubnetReservedAddressesFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::subnet_reserved_addresses(%[1]q)
+}
+
+output "reserved_addresses" {
+  value = join(",", local.test.reserved_addresses)
+}
+
+output "first_usable_address" {
+  value = local.test.first_usable_address
+}
+
+output "last_usable_address" {
+  value = local.test.last_usable_address
+}
+
+output "usable_address_count" {
+  value = local.test.usable_address_count
+}
+`, arg)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = vpcSubnetCIDRsFunction{}
+
+func NewVPCSubnetCIDRsFunction() function.Function {
+	return &vpcSubnetCIDRsFunction{}
+}
+
+type vpcSubnetCIDRsFunction struct{}
+
+func (f vpcSubnetCIDRsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_cidrs"
+}
+
+func (f vpcSubnetCIDRsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_cidrs Function",
+		MarkdownDescription: "Splits a VPC IPv4 CIDR block into an equal block per Availability Zone and allocates the same " +
+			"set of subnets in each, returning a map of Availability Zone to subnet CIDR blocks",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr_block",
+				MarkdownDescription: "VPC IPv4 CIDR block, with a prefix length between /16 and /28",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zone names or IDs",
+			},
+			function.ListParameter{
+				Name:                "newbits",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Number of additional prefix bits, relative to the VPC CIDR block, of each subnet to allocate in each Availability Zone",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ListType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (f vpcSubnetCIDRsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDRBlock string
+	var availabilityZones []string
+	var newbits []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDRBlock, &availabilityZones, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseIPv4CIDRBlock(vpcCIDRBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if len(availabilityZones) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "at least one Availability Zone is required"))
+		return
+	}
+
+	seen := make(map[string]bool, len(availabilityZones))
+	for _, v := range availabilityZones {
+		if seen[v] {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("duplicate Availability Zone %q", v)))
+			return
+		}
+		seen[v] = true
+	}
+
+	result, err := vpcSubnetCIDRs(prefix, len(availabilityZones), newbits)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	subnets := make(map[string][]string, len(availabilityZones))
+	for i, v := range availabilityZones {
+		subnets[v] = result[i]
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, subnets))
+}
+
+// vpcSubnetCIDRs splits the VPC CIDR block into a power of two number of equal blocks, at least one per Availability Zone,
+// and allocates subnets of the specified sizes, in order and each aligned to its size, in the first n blocks.
+func vpcSubnetCIDRs(vpc netip.Prefix, n int, newbits []int64) ([][]string, error) {
+	zoneBits := bits.Len(uint(n - 1))
+
+	if vpc.Bits()+zoneBits > 32 {
+		return nil, fmt.Errorf("VPC CIDR block %s is too small for %d Availability Zones", vpc, n)
+	}
+
+	zoneSize := uint64(1) << (32 - vpc.Bits() - zoneBits)
+
+	var offsets []uint64
+	var lengths []int
+	var next uint64
+
+	for _, v := range newbits {
+		if v < int64(zoneBits) {
+			return nil, fmt.Errorf("newbits %d is too small; at least %d bits are needed for %d Availability Zones", v, zoneBits, n)
+		}
+
+		length := vpc.Bits() + int(v)
+		if err := validateIPv4PrefixLength(length); err != nil {
+			return nil, fmt.Errorf("newbits %d: subnet %w", v, err)
+		}
+
+		size := uint64(1) << (32 - length)
+		offset := (next + size - 1) &^ (size - 1) // Align to the subnet size.
+
+		if offset+size > zoneSize {
+			return nil, fmt.Errorf("subnets do not fit in the /%d block for each Availability Zone", vpc.Bits()+zoneBits)
+		}
+
+		offsets = append(offsets, offset)
+		lengths = append(lengths, length)
+		next = offset + size
+	}
+
+	base := uint64(ipv4ToUint32(vpc.Addr()))
+	result := make([][]string, n)
+
+	for i := range n {
+		result[i] = make([]string, len(offsets))
+
+		for j, offset := range offsets {
+			addr := uint32ToIPv4(uint32(base + uint64(i)*zoneSize + offset))
+			result[i][j] = netip.PrefixFrom(addr, lengths[j]).String()
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetCIDRsFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetCIDRsFunctionConfig("10.0.0.0/16", `["a", "b", "c"]`, "[4, 4, 8]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "10.0.0.0/20,10.0.16.0/20,10.0.32.0/24"),
+					resource.TestCheckOutput("b", "10.0.64.0/20,10.0.80.0/20,10.0.96.0/24"),
+					resource.TestCheckOutput("c", "10.0.128.0/20,10.0.144.0/20,10.0.160.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRsFunction_doesNotFit(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetCIDRsFunctionConfig("10.0.0.0/24", `["a", "b", "c"]`, "[4, 4, 4, 4, 4]"),
+				ExpectError: regexache.MustCompile(`subnets[\s\n]*do[\s\n]*not[\s\n]*fit`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRsFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetCIDRsFunctionConfig("10.0.0.0/26", `["a", "b", "c"]`, "[4]"),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetCIDRsFunctionConfig(vpcCIDRBlock, availabilityZones, newbits string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::vpc_subnet_cidrs(%[1]q, %[2]s, %[3]s)
+}
+
+output "a" {
+  value = join(",", local.test["a"])
+}
+
+output "b" {
+  value = join(",", local.test["b"])
+}
+
+output "c" {
+  value = join(",", local.test["c"])
+}
+`, vpcCIDRBlock, availabilityZones, newbits)
+}
@@ -16,6 +16,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -125,6 +126,23 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	Permissions             *PermissionsDatum
+	Identity                *IdentityDatum
+}
+
+// PermissionsDatum represents the IAM actions required by a resource's CRUD handlers.
+type PermissionsDatum struct {
+	Create []string
+	Read   []string
+	Update []string
+	Delete []string
+}
+
+// IdentityDatum represents the attributes that uniquely identify an instance of a resource.
+type IdentityDatum struct {
+	Attributes []string
+	Global     bool
+	Separator  string
 }
 
 type ServiceDatum struct {
@@ -206,13 +224,19 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging, permissions and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
-		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
+		m := annotation.FindStringSubmatch(line)
+		if len(m) == 0 {
+			continue
+		}
+
+		switch m[1] {
+		case "Tags":
 			args := common.ParseArgs(m[3])
 
 			d.TransparentTagging = true
@@ -228,6 +252,61 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+		case "Permissions":
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) < 2 {
+				v.errs = append(v.errs, fmt.Errorf("no operation or actions in Permissions annotation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			if d.Permissions == nil {
+				d.Permissions = &PermissionsDatum{}
+			}
+
+			actions := args.Positional[1:]
+
+			switch operation := args.Positional[0]; operation {
+			case "create":
+				d.Permissions.Create = append(d.Permissions.Create, actions...)
+			case "read":
+				d.Permissions.Read = append(d.Permissions.Read, actions...)
+			case "update":
+				d.Permissions.Update = append(d.Permissions.Update, actions...)
+			case "delete":
+				d.Permissions.Delete = append(d.Permissions.Delete, actions...)
+			default:
+				v.errs = append(v.errs, fmt.Errorf("unknown operation (%s) in Permissions annotation: %s", operation, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+		case "Identity":
+			args := common.ParseArgs(m[3])
+
+			if d.Identity != nil {
+				v.errs = append(v.errs, fmt.Errorf("multiple Identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no attributes in Identity annotation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.Identity = &IdentityDatum{
+				Attributes: args.Positional,
+				Separator:  ",", // flex.ResourceIdSeparator.
+			}
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid global value (%s) in Identity annotation: %s", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					d.Identity.Global = global
+				}
+			}
+
+			if attr, ok := args.Keyword["separator"]; ok {
+				d.Identity.Separator = attr
+			}
 		}
 	}
 
@@ -282,7 +361,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Identity", "Permissions", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -6,11 +6,13 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -67,6 +69,12 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// resourcePlanInterceptor is implemented by resource interceptors that are also invoked when a change is planned.
+type resourcePlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient)
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
@@ -200,13 +208,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// region is whether the data source has the per-resource `region` argument.
+	region bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, region bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
@@ -218,15 +229,23 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region {
+		addDataSourceRegionAttribute(&response.Schema)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		w.innerRead(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	if w.region {
+		ctx = regionContext(ctx, request.Config.Raw)
+	}
+	meta := w.configureForRegion(ctx)
+	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -238,6 +257,19 @@ func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.Co
 	w.inner.Configure(ctx, request, response)
 }
 
+// apiCallLogDataSourceInterceptor attributes the AWS API calls made by a data source's Read handler to the data source.
+type apiCallLogDataSourceInterceptor struct {
+	typeName string
+}
+
+func (r apiCallLogDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = conns.NewAPICallLogContext(ctx, r.typeName, stateID(ctx, &response.State), "read")
+	}
+
+	return ctx, diags
+}
+
 // tagsDataSourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -255,13 +287,20 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	permissions      *types.ServicePackageResourcePermissions
+	identity         *types.ServicePackageResourceIdentity
+	// region is whether the resource has the per-resource `region` argument.
+	region bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, permissions *types.ServicePackageResourcePermissions, identity *types.ServicePackageResourceIdentity, region bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		permissions:      permissions,
+		identity:         identity,
+		region:           region,
 	}
 }
 
@@ -273,45 +312,93 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	addUnionValidators(ctx, &response.Schema)
+
+	if w.region {
+		addRegionAttribute(&response.Schema)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		w.innerCreate(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+	if w.region {
+		ctx = regionContext(ctx, request.Plan.Raw)
+	}
+	meta := w.configureForRegion(ctx)
+	diags := interceptedResourceHandler(w.interceptors.create(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		w.innerRead(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	if w.region {
+		ctx = regionContext(ctx, request.State.Raw)
+	}
+	meta := w.configureForRegion(ctx)
+	diags := interceptedResourceHandler(w.interceptors.read(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
+
+	w.verifyIdentity(ctx, request, response)
+}
+
+// verifyIdentity verifies that the resource's identity attributes are not changed by refresh.
+func (w *wrappedResource) verifyIdentity(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	// Error or resource removed from state.
+	if w.identity == nil || response.Diagnostics.HasError() || response.State.Raw.IsNull() {
+		return
+	}
+
+	before, diags := identityAttributeValues(ctx, w.identity, request.State)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	after, diags := identityAttributeValues(ctx, w.identity, response.State)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if changed := conns.ChangedIdentityAttributes(w.identity, before, after); len(changed) > 0 {
+		response.Diagnostics.AddError("Resource identity changed", fmt.Sprintf("The following identity attributes changed during refresh: %s", strings.Join(changed, ", ")))
+	}
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		w.innerUpdate(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+	if w.region {
+		ctx = regionContext(ctx, request.Plan.Raw)
+	}
+	meta := w.configureForRegion(ctx)
+	diags := interceptedResourceHandler(w.interceptors.update(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		w.innerDelete(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+	if w.region {
+		ctx = regionContext(ctx, request.State.Raw)
+	}
+	meta := w.configureForRegion(ctx)
+	diags := interceptedResourceHandler(w.interceptors.delete(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -326,7 +413,58 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.region {
+			// The import ID may specify the Region, e.g. `vpc-12345678@eu-west-1`.
+			id, region, err := conns.RegionalImportID(request.ID)
+			if err != nil {
+				response.Diagnostics.AddError("Invalid import ID", err.Error())
+
+				return
+			}
+
+			if region != "" {
+				ctx = conns.NewRegionContext(ctx, region)
+				w.configureForRegion(ctx)
+				request.ID = id
+
+				defer func() {
+					if !response.Diagnostics.HasError() {
+						response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+					}
+				}()
+			}
+		}
+
+		if w.identity == nil || !conns.IsImportIdentity(request.ID) {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// The import ID is a resource identity object.
+		if w.meta == nil {
+			response.Diagnostics.AddError("Unable to import resource by identity", "The provider has not been configured.")
+
+			return
+		}
+
+		identity, err := w.meta.ParseImportIdentity(ctx, w.identity, request.ID)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid resource identity", err.Error())
+
+			return
+		}
+
+		request.ID = identity.ID
 		v.ImportState(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for name, value := range identity.Attributes {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(name), value)...)
+		}
 
 		return
 	}
@@ -338,9 +476,80 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.region {
+		ctx = regionContext(ctx, request.Config.Raw)
+		w.configureForRegion(ctx)
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		w.innerModifyPlan(ctx, v, request, response)
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.planRegion(ctx, request, response)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.planInterceptors(ctx, request, response)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.permissionsPreflight(ctx, request, response)
+}
+
+// planInterceptors runs, first to last, any interceptors that are invoked when a change is planned.
+func (w *wrappedResource) planInterceptors(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourcePlanInterceptor); ok {
+			v.modifyPlan(ctx, request, response, w.meta)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+}
+
+// permissionsPreflight checks that the caller identity is allowed to perform the IAM actions required to apply the planned change.
+func (w *wrappedResource) permissionsPreflight(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.permissions == nil || w.meta == nil || w.meta.PermissionsPreflightMode() == "" {
+		return
+	}
+
+	// Destroy or no change.
+	if request.Plan.Raw.IsNull() || request.Plan.Raw.Equal(request.State.Raw) {
+		return
+	}
+
+	ctx = w.bootstrapContext(ctx, w.meta)
+	create := request.State.Raw.IsNull()
+	missing, err := w.meta.MissingPermissions(ctx, conns.PermissionsPreflightActions(w.permissions, create))
+
+	var summary, detail string
+
+	switch {
+	case err != nil:
+		summary, detail = "Unable to check IAM permissions", err.Error()
+	case len(missing) == 0:
+		return
+	default:
+		summary, detail = "Missing IAM permissions", fmt.Sprintf("The caller identity is not allowed to perform the following actions: %s", strings.Join(missing, ", "))
+	}
+
+	if w.meta.PermissionsPreflightMode() == conns.PermissionsPreflightModeError {
+		response.Diagnostics.AddError(summary, detail)
+	} else {
+		response.Diagnostics.AddWarning(summary, detail)
 	}
 }
 
@@ -356,7 +565,7 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+		w.innerValidateConfig(ctx, v, request, response)
 	}
 }
 
@@ -364,7 +573,7 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.UpgradeState(ctx)
+		return w.innerUpgradeState(ctx, v.UpgradeState(ctx))
 	}
 
 	return nil
@@ -374,17 +583,85 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.MoveState(ctx)
+		return w.innerMoveState(ctx, v.MoveState(ctx))
 	}
 
 	return nil
 }
 
+// identityAttributeValues returns the values of a resource's identity attributes.
+func identityAttributeValues(ctx context.Context, identity *types.ServicePackageResourceIdentity, state tfsdk.State) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := make(map[string]string, len(identity.Attributes))
+
+	if state.Raw.IsNull() {
+		return values, diags
+	}
+
+	for _, k := range identity.Attributes {
+		var v *string
+		diags.Append(state.GetAttribute(ctx, path.Root(k), &v)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v != nil {
+			values[k] = *v
+		}
+	}
+
+	return values, diags
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
 }
 
+// modifyPlan checks that the resource has all of the provider's required tags.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) {
+	if r.tags == nil {
+		return
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var planTags fwtypes.Map
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if planTags.IsUnknown() {
+		return
+	}
+
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+
+	if missing := tagsInContext.DefaultConfig.MissingRequiredTags(tags); len(missing) > 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrTags),
+			"Missing Required Tags",
+			fmt.Sprintf("The following tags are required by the provider's default_tags configuration: %s", strings.Join(missing, ", ")),
+		)
+	}
+}
+
 func (r tagsResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
@@ -653,3 +930,53 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// apiCallLogResourceInterceptor attributes the AWS API calls made by a resource's CRUD handlers to the resource.
+type apiCallLogResourceInterceptor struct {
+	typeName string
+}
+
+func (r apiCallLogResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = conns.NewAPICallLogContext(ctx, r.typeName, stateID(ctx, &response.State), "create")
+	}
+
+	return ctx, diags
+}
+
+func (r apiCallLogResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = conns.NewAPICallLogContext(ctx, r.typeName, stateID(ctx, &request.State), "read")
+	}
+
+	return ctx, diags
+}
+
+func (r apiCallLogResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = conns.NewAPICallLogContext(ctx, r.typeName, stateID(ctx, &request.State), "update")
+	}
+
+	return ctx, diags
+}
+
+func (r apiCallLogResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = conns.NewAPICallLogContext(ctx, r.typeName, stateID(ctx, &request.State), "delete")
+	}
+
+	return ctx, diags
+}
+
+// stateID returns a function that returns the value of the `id` attribute in the specified state, if any.
+func stateID(ctx context.Context, state *tfsdk.State) func() string {
+	return func() string {
+		var id fwtypes.String
+
+		if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+			return ""
+		}
+
+		return id.ValueString()
+	}
+}
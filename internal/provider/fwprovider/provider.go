@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -56,6 +57,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON Lines record of every AWS API call is appended. Can also be set using the `TF_AWS_API_CALL_LOG_FILE` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -68,6 +73,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL from which all service endpoints are derived, e.g. for a local AWS emulator. Endpoints set in the `endpoints` block take precedence. Unless set explicitly, `s3_use_path_style`, `skip_credentials_validation`, `skip_metadata_api_check` and `skip_region_validation` default to `true`.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -92,6 +101,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"permissions_preflight": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies whether IAM permissions required by resources are checked during plan. Valid values are `warning` and `error`.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -148,6 +161,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"tag_policy_compliance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies whether planned tags are validated against the account's effective AWS Organizations tag policy. Valid values are `warning` and `error`.",
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -167,9 +184,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume in turn, in the order listed, to obtain the credentials used to make API calls.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -259,12 +274,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"required_tags": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that all taggable resources must have",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"resource_type": defaultTagsOverrideBlock("Resource tags to default across all resources of a type, overriding service and provider-wide default tags"),
+						"service":       defaultTagsOverrideBlock("Resource tags to default across all resources in a service, overriding provider-wide default tags"),
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -288,6 +312,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of AWS API calls made to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrency": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent API calls made to the service.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum number of API calls made to the service per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The provider service package name, e.g. `iam` or `route53`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -335,13 +378,15 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{
+				apiCallLogDataSourceInterceptor{typeName: typeName},
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -362,8 +407,23 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
+			// Regional data sources have the per-resource `region` argument.
+			region := !names.IsGlobalService(servicePackageName)
+			if region {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					region = false
+				}
+			}
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				// Create a new inner data source for each request, as it may be configured for a different Region.
+				// Any error creating it has been reported above.
+				inner, _ := v.Factory(ctx)
+
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, region)
 			})
 		}
 	}
@@ -405,14 +465,15 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewTagPolicyContext(ctx, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			var interceptors resourceInterceptors
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -442,8 +503,48 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			// The API call log interceptor is added last so that it runs immediately before the CRUD handler.
+			interceptors = append(interceptors, apiCallLogResourceInterceptor{typeName: typeName})
+
+			if v := v.Identity; v != nil {
+				// The resource has declared its identity attributes.
+				// Ensure that the schema looks OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				var err error
+				for _, k := range v.Attributes {
+					if v, ok := schemaResponse.Schema.Attributes[k]; !ok {
+						err = fmt.Errorf("no `%s` identity attribute defined in schema: %s", k, typeName)
+						break
+					} else if !v.GetType().TerraformType(ctx).Is(tftypes.String) {
+						err = fmt.Errorf("`%s` identity attribute must be of type String: %s", k, typeName)
+						break
+					}
+				}
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+			}
+
+			// Regional resources have the per-resource `region` argument.
+			region := !names.IsGlobalService(servicePackageName) && (v.Identity == nil || !v.Identity.Global)
+			if region {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					region = false
+				}
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				// Create a new inner resource for each request, as it may be configured for a different Region.
+				// Any error creating it has been reported above.
+				inner, _ := v.Factory(ctx)
+
+				return newWrappedResource(bootstrapContext, inner, interceptors, v.Permissions, v.Identity, region)
 			})
 		}
 	}
@@ -466,7 +567,33 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewECSContainerDefinitionsFunction,
+		tffunction.NewPolicyEquivalentFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewPolicyNormalizeFunction,
+		tffunction.NewSubnetReservedAddressesFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetCIDRsFunction,
+	}
+}
+
+func defaultTagsOverrideBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:    true,
+					Description: "The service package name, e.g. `ec2`, or the resource type name, e.g. `aws_instance`.",
+				},
+				"tags": schema.MapAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+					Description: "Resource tags to default",
+				},
+			},
+		},
 	}
 }
 
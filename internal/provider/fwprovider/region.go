@@ -0,0 +1,541 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The per-resource `region` argument is injected into the schemas of regional resources and data sources.
+// Resource and data source implementations are unaware of the argument:
+// it is removed from the Config, Plan and State values passed to them and added to the values they return.
+
+var (
+	regionValidators = []validator.String{
+		stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`), "must be a valid AWS Region Code"),
+	}
+)
+
+// addRegionAttribute adds the `region` argument to a resource's schema.
+func addRegionAttribute(s *schema.Schema) {
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]schema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		Validators:  regionValidators,
+	}
+}
+
+// addDataSourceRegionAttribute adds the `region` argument to a data source's schema.
+func addDataSourceRegionAttribute(s *dsschema.Schema) {
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]dsschema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the data source is read. Defaults to the Region set in the provider configuration.",
+		Validators:  regionValidators,
+	}
+}
+
+// regionAttributeValue returns the value of the `region` attribute of the specified object.
+func regionAttributeValue(v tftypes.Value) tftypes.Value {
+	if !v.IsNull() && v.IsKnown() {
+		var m map[string]tftypes.Value
+		if err := v.As(&m); err == nil {
+			if v, ok := m[names.AttrRegion]; ok {
+				return v
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionFromValue returns the value of the `region` attribute of the specified object, if it is known and not null.
+func regionFromValue(v tftypes.Value) string {
+	var region string
+
+	if v := regionAttributeValue(v); !v.IsNull() && v.IsKnown() {
+		if err := v.As(&region); err != nil {
+			return ""
+		}
+	}
+
+	return region
+}
+
+// regionContext returns a Context in which AWS API calls are routed to the Region specified in the object, if any.
+func regionContext(ctx context.Context, v tftypes.Value) context.Context {
+	if region := regionFromValue(v); region != "" {
+		ctx = conns.NewRegionContext(ctx, region)
+	}
+
+	return ctx
+}
+
+// metaForContext returns the AWS client for the Region in Context, if any.
+func metaForContext(ctx context.Context, meta *conns.AWSClient) *conns.AWSClient {
+	if meta != nil {
+		if region, ok := conns.RegionFromContext(ctx); ok {
+			return meta.ForRegion(ctx, region)
+		}
+	}
+
+	return meta
+}
+
+// configureForRegion configures the inner resource with the AWS client for the Region in Context, if any,
+// so that the resource's Meta(), used for example to build ARNs, is for that Region.
+// A new inner resource is created for each request so other requests are not affected.
+func (w *wrappedResource) configureForRegion(ctx context.Context) *conns.AWSClient {
+	meta := metaForContext(ctx, w.meta)
+
+	if meta != w.meta {
+		w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+	}
+
+	return meta
+}
+
+// configureForRegion configures the inner data source with the AWS client for the Region in Context, if any.
+// A new inner data source is created for each request so other requests are not affected.
+func (w *wrappedDataSource) configureForRegion(ctx context.Context) *conns.AWSClient {
+	meta := metaForContext(ctx, w.meta)
+
+	if meta != w.meta {
+		w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &datasource.ConfigureResponse{})
+	}
+
+	return meta
+}
+
+// withoutRegion returns a copy of the specified object, of type `typ`, without its `region` attribute.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), diags
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), diags
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		diags.AddError("Removing region attribute", err.Error())
+		return v, diags
+	}
+
+	delete(m, names.AttrRegion)
+
+	return newObjectValue(typ, m, diags)
+}
+
+// withRegion returns a copy of the specified object, of type `typ`, with the specified `region` attribute value.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), diags
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), diags
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		diags.AddError("Adding region attribute", err.Error())
+		return v, diags
+	}
+
+	m[names.AttrRegion] = region
+
+	return newObjectValue(typ, m, diags)
+}
+
+func newObjectValue(typ tftypes.Type, m map[string]tftypes.Value, diags diag.Diagnostics) (tftypes.Value, diag.Diagnostics) {
+	if err := tftypes.ValidateValue(typ, m); err != nil {
+		diags.AddError("Creating object value", fmt.Sprintf("%s: %s", typ, err))
+		return tftypes.NewValue(typ, nil), diags
+	}
+
+	return tftypes.NewValue(typ, m), diags
+}
+
+// regionValue returns the Terraform value of a Region, which is null if empty.
+func regionValue(region string) tftypes.Value {
+	if region == "" {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, region)
+}
+
+// effectiveRegion returns the Region specified in the object or else the provider's Region.
+func effectiveRegion(v tftypes.Value, meta *conns.AWSClient) string {
+	if region := regionFromValue(v); region != "" {
+		return region
+	}
+
+	if meta != nil {
+		return meta.Region
+	}
+
+	return ""
+}
+
+// regionConverter converts object values between a schema with the `region` attribute (outer) and
+// the resource's or data source's own schema (inner).
+type regionConverter struct {
+	diags     diag.Diagnostics
+	innerType tftypes.Type
+	outerType tftypes.Type
+}
+
+func newRegionConverter(ctx context.Context, inner, outer attr.Type) *regionConverter {
+	return &regionConverter{
+		innerType: inner.TerraformType(ctx),
+		outerType: outer.TerraformType(ctx),
+	}
+}
+
+func (c *regionConverter) inner(v tftypes.Value) tftypes.Value {
+	v, diags := withoutRegion(v, c.innerType)
+	c.diags.Append(diags...)
+
+	return v
+}
+
+func (c *regionConverter) outer(v tftypes.Value, region tftypes.Value) tftypes.Value {
+	v, diags := withRegion(v, c.outerType, region)
+	c.diags.Append(diags...)
+
+	return v
+}
+
+// innerSchema returns the inner resource's schema and a converter between it and the specified schema type.
+func (w *wrappedResource) innerSchema(ctx context.Context, outer attr.Type) (schema.Schema, *regionConverter) {
+	response := resource.SchemaResponse{}
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	c := newRegionConverter(ctx, response.Schema.Type(), outer)
+	c.diags.Append(response.Diagnostics...)
+
+	return response.Schema, c
+}
+
+func (w *wrappedResource) innerCreate(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if !w.region {
+		w.inner.Create(ctx, request, response)
+		return
+	}
+
+	s, c := w.innerSchema(ctx, request.Plan.Schema.Type())
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: s, Raw: c.inner(request.Config.Raw)}
+	innerRequest.Plan = tfsdk.Plan{Schema: s, Raw: c.inner(request.Plan.Raw)}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Schema: s, Raw: c.inner(response.State.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	innerResponse.State = tfsdk.State{Schema: response.State.Schema, Raw: c.outer(innerResponse.State.Raw, regionValue(effectiveRegion(request.Plan.Raw, w.meta)))}
+	innerResponse.Diagnostics.Append(c.diags...)
+	*response = innerResponse
+}
+
+func (w *wrappedResource) innerRead(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	if !w.region {
+		w.inner.Read(ctx, request, response)
+		return
+	}
+
+	s, c := w.innerSchema(ctx, request.State.Schema.Type())
+	innerRequest := request
+	innerRequest.State = tfsdk.State{Schema: s, Raw: c.inner(request.State.Raw)}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Schema: s, Raw: c.inner(response.State.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	// Resources refreshed for the first time since the argument was available are in the provider's Region.
+	innerResponse.State = tfsdk.State{Schema: response.State.Schema, Raw: c.outer(innerResponse.State.Raw, regionValue(effectiveRegion(request.State.Raw, w.meta)))}
+	innerResponse.Diagnostics.Append(c.diags...)
+	*response = innerResponse
+}
+
+func (w *wrappedResource) innerUpdate(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if !w.region {
+		w.inner.Update(ctx, request, response)
+		return
+	}
+
+	s, c := w.innerSchema(ctx, request.Plan.Schema.Type())
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: s, Raw: c.inner(request.Config.Raw)}
+	innerRequest.Plan = tfsdk.Plan{Schema: s, Raw: c.inner(request.Plan.Raw)}
+	innerRequest.State = tfsdk.State{Schema: s, Raw: c.inner(request.State.Raw)}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Schema: s, Raw: c.inner(response.State.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	innerResponse.State = tfsdk.State{Schema: response.State.Schema, Raw: c.outer(innerResponse.State.Raw, regionValue(effectiveRegion(request.Plan.Raw, w.meta)))}
+	innerResponse.Diagnostics.Append(c.diags...)
+	*response = innerResponse
+}
+
+func (w *wrappedResource) innerDelete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if !w.region {
+		w.inner.Delete(ctx, request, response)
+		return
+	}
+
+	s, c := w.innerSchema(ctx, request.State.Schema.Type())
+	innerRequest := request
+	innerRequest.State = tfsdk.State{Schema: s, Raw: c.inner(request.State.Raw)}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Schema: s, Raw: c.inner(response.State.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	innerResponse.State = tfsdk.State{Schema: response.State.Schema, Raw: c.outer(innerResponse.State.Raw, regionValue(effectiveRegion(request.State.Raw, w.meta)))}
+	innerResponse.Diagnostics.Append(c.diags...)
+	*response = innerResponse
+}
+
+func (w *wrappedResource) innerModifyPlan(ctx context.Context, v resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !w.region {
+		v.ModifyPlan(ctx, request, response)
+		return
+	}
+
+	s, c := w.innerSchema(ctx, request.Config.Schema.Type())
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: s, Raw: c.inner(request.Config.Raw)}
+	innerRequest.Plan = tfsdk.Plan{Schema: s, Raw: c.inner(request.Plan.Raw)}
+	innerRequest.State = tfsdk.State{Schema: s, Raw: c.inner(request.State.Raw)}
+	innerResponse := *response
+	innerResponse.Plan = tfsdk.Plan{Schema: s, Raw: c.inner(response.Plan.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	innerResponse.Plan = tfsdk.Plan{Schema: response.Plan.Schema, Raw: c.outer(innerResponse.Plan.Raw, regionAttributeValue(response.Plan.Raw))}
+	innerResponse.Diagnostics.Append(c.diags...)
+	*response = innerResponse
+}
+
+func (w *wrappedResource) innerValidateConfig(ctx context.Context, v resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	if !w.region {
+		v.ValidateConfig(ctx, request, response)
+		return
+	}
+
+	s, c := w.innerSchema(ctx, request.Config.Schema.Type())
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: s, Raw: c.inner(request.Config.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	v.ValidateConfig(ctx, innerRequest, response)
+}
+
+// planRegion plans the provider's Region for a resource with no configured `region` argument.
+// A change to the provider's Region forces replacement of such a resource, as it did before the argument was available.
+func (w *wrappedResource) planRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if !w.region || w.meta == nil || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion fwtypes.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if configRegion.IsNull() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.Region)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Create.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var planRegion, stateRegion fwtypes.String
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources with no Region in state have not been refreshed since the argument was available.
+	if stateRegion.ValueString() != "" && !planRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+}
+
+func (w *wrappedResource) innerUpgradeState(ctx context.Context, upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	if !w.region {
+		return upgraders
+	}
+
+	for version, upgrader := range upgraders {
+		f := upgrader.StateUpgrader
+		if f == nil {
+			continue
+		}
+
+		upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			s, c := w.innerSchema(ctx, response.State.Schema.Type())
+			innerResponse := *response
+			innerResponse.State = tfsdk.State{Schema: s, Raw: c.inner(response.State.Raw)}
+
+			if c.diags.HasError() {
+				response.Diagnostics.Append(c.diags...)
+				return
+			}
+
+			f(ctx, request, &innerResponse)
+
+			if v := innerResponse.DynamicValue; v != nil {
+				raw, err := v.Unmarshal(c.innerType)
+				if err != nil {
+					innerResponse.Diagnostics.AddError("Unable to Convert Upgraded Resource State", err.Error())
+				} else {
+					innerResponse.State.Raw = raw
+				}
+				innerResponse.DynamicValue = nil
+			}
+
+			// The Region is set by the following refresh.
+			innerResponse.State = tfsdk.State{Schema: response.State.Schema, Raw: c.outer(innerResponse.State.Raw, regionValue(""))}
+			innerResponse.Diagnostics.Append(c.diags...)
+			*response = innerResponse
+		}
+		upgraders[version] = upgrader
+	}
+
+	return upgraders
+}
+
+func (w *wrappedResource) innerMoveState(ctx context.Context, movers []resource.StateMover) []resource.StateMover {
+	if !w.region {
+		return movers
+	}
+
+	for i, mover := range movers {
+		f := mover.StateMover
+		if f == nil {
+			continue
+		}
+
+		mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			s, c := w.innerSchema(ctx, response.TargetState.Schema.Type())
+			innerResponse := *response
+			innerResponse.TargetState = tfsdk.State{Schema: s, Raw: c.inner(response.TargetState.Raw)}
+
+			if c.diags.HasError() {
+				response.Diagnostics.Append(c.diags...)
+				return
+			}
+
+			f(ctx, request, &innerResponse)
+
+			// The Region is set by the following refresh.
+			innerResponse.TargetState = tfsdk.State{Schema: response.TargetState.Schema, Raw: c.outer(innerResponse.TargetState.Raw, regionValue(""))}
+			innerResponse.Diagnostics.Append(c.diags...)
+			*response = innerResponse
+		}
+		movers[i] = mover
+	}
+
+	return movers
+}
+
+// innerRead calls the inner data source's Read method.
+func (w *wrappedDataSource) innerRead(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	if !w.region {
+		w.inner.Read(ctx, request, response)
+		return
+	}
+
+	schemaResponse := datasource.SchemaResponse{}
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+	s := schemaResponse.Schema
+	c := newRegionConverter(ctx, s.Type(), request.Config.Schema.Type())
+	c.diags.Append(schemaResponse.Diagnostics...)
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: s, Raw: c.inner(request.Config.Raw)}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Schema: s, Raw: c.inner(response.State.Raw)}
+
+	if c.diags.HasError() {
+		response.Diagnostics.Append(c.diags...)
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	innerResponse.State = tfsdk.State{Schema: response.State.Schema, Raw: c.outer(innerResponse.State.Raw, regionValue(effectiveRegion(request.Config.Raw, w.meta)))}
+	innerResponse.Diagnostics.Append(c.diags...)
+	*response = innerResponse
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// addUnionValidators adds the Union validator to each of a resource schema's nested objects whose data model
+// implements fwflex.Union, so that exactly one of the union's members must be configured.
+// Only nested objects with a custom type implementing fwtypes.NestedObjectType have a known data model.
+func addUnionValidators(ctx context.Context, s *schema.Schema) {
+	s.Attributes = unionValidatorsForAttributes(ctx, s.Attributes)
+	s.Blocks = unionValidatorsForBlocks(ctx, s.Blocks)
+}
+
+func unionValidatorsForAttributes(ctx context.Context, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	if len(attributes) == 0 {
+		return attributes
+	}
+
+	attributes = maps.Clone(attributes)
+
+	for name, attribute := range attributes {
+		switch v := attribute.(type) {
+		case schema.ListNestedAttribute:
+			v.NestedObject = unionValidatorsForNestedAttributeObject(ctx, v.NestedObject, v.CustomType)
+			attributes[name] = v
+		case schema.SetNestedAttribute:
+			v.NestedObject = unionValidatorsForNestedAttributeObject(ctx, v.NestedObject, v.CustomType)
+			attributes[name] = v
+		case schema.MapNestedAttribute:
+			v.NestedObject = unionValidatorsForNestedAttributeObject(ctx, v.NestedObject, v.CustomType)
+			attributes[name] = v
+		case schema.SingleNestedAttribute:
+			v.Attributes = unionValidatorsForAttributes(ctx, v.Attributes)
+			v.Validators = unionValidators(ctx, v.Validators, v.CustomType)
+			attributes[name] = v
+		}
+	}
+
+	return attributes
+}
+
+func unionValidatorsForNestedAttributeObject(ctx context.Context, object schema.NestedAttributeObject, typ attr.Type) schema.NestedAttributeObject {
+	object.Attributes = unionValidatorsForAttributes(ctx, object.Attributes)
+	object.Validators = unionValidators(ctx, object.Validators, typ)
+
+	return object
+}
+
+func unionValidatorsForBlocks(ctx context.Context, blocks map[string]schema.Block) map[string]schema.Block {
+	if len(blocks) == 0 {
+		return blocks
+	}
+
+	blocks = maps.Clone(blocks)
+
+	for name, block := range blocks {
+		switch v := block.(type) {
+		case schema.ListNestedBlock:
+			v.NestedObject = unionValidatorsForNestedBlockObject(ctx, v.NestedObject, v.CustomType)
+			blocks[name] = v
+		case schema.SetNestedBlock:
+			v.NestedObject = unionValidatorsForNestedBlockObject(ctx, v.NestedObject, v.CustomType)
+			blocks[name] = v
+		case schema.SingleNestedBlock:
+			v.Attributes = unionValidatorsForAttributes(ctx, v.Attributes)
+			v.Blocks = unionValidatorsForBlocks(ctx, v.Blocks)
+			v.Validators = unionValidators(ctx, v.Validators, v.CustomType)
+			blocks[name] = v
+		}
+	}
+
+	return blocks
+}
+
+func unionValidatorsForNestedBlockObject(ctx context.Context, object schema.NestedBlockObject, typ attr.Type) schema.NestedBlockObject {
+	object.Attributes = unionValidatorsForAttributes(ctx, object.Attributes)
+	object.Blocks = unionValidatorsForBlocks(ctx, object.Blocks)
+	object.Validators = unionValidators(ctx, object.Validators, typ)
+
+	return object
+}
+
+// unionValidators returns the specified validators with the Union validator appended if the nested object type's data model implements fwflex.Union.
+func unionValidators(ctx context.Context, validators []validator.Object, typ attr.Type) []validator.Object {
+	if !isUnionType(ctx, typ) {
+		return validators
+	}
+
+	return append(validators[:len(validators):len(validators)], fwvalidators.Union())
+}
+
+func isUnionType(ctx context.Context, typ attr.Type) bool {
+	v, ok := typ.(fwtypes.NestedObjectType)
+	if !ok {
+		return false
+	}
+
+	ptr, diags := v.NewObjectPtr(ctx)
+	if diags.HasError() {
+		return false
+	}
+
+	_, ok = ptr.(fwflex.Union)
+
+	return ok
+}
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -79,6 +81,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -110,7 +127,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		diags = f(ctx, d, metaForContext(ctx, meta))
 
 		if diags.HasError() {
 			when = OnError
@@ -195,6 +212,308 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// apiCallLogInterceptor attributes the AWS API calls made by a resource's or data source's CRUD handlers to the resource or data source.
+type apiCallLogInterceptor struct {
+	typeName string
+}
+
+func (r apiCallLogInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = conns.NewAPICallLogContext(ctx, r.typeName, d.Id, why.String())
+	}
+
+	return ctx, diags
+}
+
+// metaForContext returns the provider Meta (instance data) for any per-resource Region kept in Context.
+func metaForContext(ctx context.Context, meta any) any {
+	if c, ok := meta.(*conns.AWSClient); ok {
+		if region, ok := conns.RegionFromContext(ctx); ok {
+			return c.ForRegion(ctx, region)
+		}
+	}
+
+	return meta
+}
+
+// regionInterceptor implements the per-resource `region` argument.
+// AWS API calls made by the CRUD handlers are routed to the resource's Region.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			ctx = conns.NewRegionContext(ctx, v)
+		}
+	case After:
+		// Set the default Region in state, e.g. for resources created before the argument was available.
+		if why&(Create|Read) != 0 && d.Id() != "" {
+			if v, ok := d.Get(names.AttrRegion).(string); !ok || v == "" {
+				if err := d.Set(names.AttrRegion, c.Region); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff returns a CustomizeDiffFunc that plans the provider's Region for a resource with no configured
+// `region` argument before calling any existing CustomizeDiffFunc.
+// A change to the provider's Region forces replacement of such a resource, as it did before the argument was available.
+func regionCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if c, ok := meta.(*conns.AWSClient); ok {
+			if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && config.GetAttr(names.AttrRegion).IsNull() {
+				// Resources with no Region in state have not been refreshed since the argument was available.
+				if o, _ := d.GetChange(names.AttrRegion); d.Id() == "" || o.(string) != "" && o.(string) != c.Region {
+					if err := d.SetNew(names.AttrRegion, c.Region); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		// AWS API calls made by the resource's CustomizeDiff are routed to the resource's planned Region.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			ctx = conns.NewRegionContext(ctx, v)
+		}
+
+		return f(ctx, d, metaForContext(ctx, meta))
+	}
+}
+
+// regionImporter returns a StateContextFunc that accepts an import ID with an `@REGION` suffix.
+// The suffix is removed from the import ID and the resource's `region` argument is set before calling the existing StateContextFunc.
+func regionImporter(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		id, region, err := conns.RegionalImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		if region == "" {
+			return f(ctx, d, meta)
+		}
+
+		d.SetId(id)
+		ctx = conns.NewRegionContext(ctx, region)
+
+		results, err := f(ctx, d, metaForContext(ctx, meta))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range results {
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		}
+
+		return results, nil
+	}
+}
+
+// permissionsPreflightCustomizeDiff returns a CustomizeDiffFunc that checks, during plan, that the caller identity
+// is allowed to perform the IAM actions required to apply the planned change before calling any existing CustomizeDiffFunc.
+// Plugin SDK CustomizeDiff cannot return warning diagnostics, so in warning mode missing permissions are logged during plan
+// and reported as warnings by permissionsPreflightInterceptor when the change is applied.
+func permissionsPreflightCustomizeDiff(permissions *types.ServicePackageResourcePermissions, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if c, ok := meta.(*conns.AWSClient); ok && c.PermissionsPreflightMode() != "" {
+			create := d.Id() == ""
+
+			if create || len(d.GetChangedKeysPrefix("")) > 0 {
+				missing, err := c.MissingPermissions(ctx, conns.PermissionsPreflightActions(permissions, create))
+
+				switch mode := c.PermissionsPreflightMode(); {
+				case err != nil && mode == conns.PermissionsPreflightModeError:
+					return fmt.Errorf("checking IAM permissions: %w", err)
+				case err != nil:
+					tflog.Warn(ctx, "checking IAM permissions", map[string]any{
+						"error": err.Error(),
+					})
+				case len(missing) == 0:
+				case mode == conns.PermissionsPreflightModeError:
+					return fmt.Errorf("missing IAM permissions: %s", strings.Join(missing, ", "))
+				default:
+					tflog.Warn(ctx, "missing IAM permissions", map[string]any{
+						"actions": missing,
+					})
+				}
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// permissionsPreflightInterceptor reports, as warnings, any IAM actions required to create or update a resource
+// that the caller identity is not allowed to perform when the provider's permissions preflight mode is warning.
+// Results are cached by the client, so the IAM actions are not simulated again after the check during plan.
+type permissionsPreflightInterceptor struct {
+	permissions *types.ServicePackageResourcePermissions
+}
+
+func (r permissionsPreflightInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.PermissionsPreflightMode() != conns.PermissionsPreflightModeWarning {
+		return ctx, diags
+	}
+
+	if when == Before && why&(Create|Update) != 0 {
+		missing, err := c.MissingPermissions(ctx, conns.PermissionsPreflightActions(r.permissions, why == Create))
+
+		switch {
+		case err != nil:
+			diags = sdkdiag.AppendWarningf(diags, "Unable to check IAM permissions: %s", err)
+		case len(missing) > 0:
+			diags = sdkdiag.AppendWarningf(diags, "Missing IAM permissions: the caller identity is not allowed to perform the following actions: %s", strings.Join(missing, ", "))
+		}
+	}
+
+	return ctx, diags
+}
+
+// tagPolicyInterceptor reports, as warnings, any violations of the effective Organizations tag policy by the tags of a resource
+// being created or whose tags are being updated.
+// Plugin SDK CustomizeDiff cannot return warning diagnostics, so violations that are not errors are only logged during plan.
+type tagPolicyInterceptor struct{}
+
+func (r tagPolicyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.TagPolicyComplianceMode() == "" {
+		return ctx, diags
+	}
+
+	if when != Before || why == Update && !d.HasChange(names.AttrTagsAll) {
+		return ctx, diags
+	}
+
+	tagsAll, _ := d.Get(names.AttrTagsAll).(map[string]any)
+	violations, err := c.TagPolicyViolations(ctx, tftags.New(ctx, tagsAll))
+
+	if err != nil {
+		return ctx, sdkdiag.AppendWarningf(diags, "Unable to check tag policy compliance: %s", err)
+	}
+
+	for _, v := range violations {
+		// Enforced violations in error mode have already failed the plan.
+		if c.TagPolicyComplianceMode() == conns.TagPolicyComplianceModeError && v.Enforced {
+			continue
+		}
+
+		diags = sdkdiag.AppendWarningf(diags, "Tag policy violation: %s", v)
+	}
+
+	return ctx, diags
+}
+
+// requiredTagsCustomizeDiff returns a CustomizeDiffFunc that checks that the resource has all of the provider's required tags
+// before calling any existing CustomizeDiffFunc.
+func requiredTagsCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if tagsInContext, ok := tftags.FromContext(ctx); ok {
+			if plan := d.GetRawPlan(); !plan.IsNull() && plan.GetAttr(names.AttrTags).IsWhollyKnown() {
+				tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+
+				if missing := tagsInContext.DefaultConfig.MissingRequiredTags(tags); len(missing) > 0 {
+					return fmt.Errorf("missing required tags: %s", strings.Join(missing, ", "))
+				}
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// identityImporter returns a StateContextFunc that accepts either a legacy import ID or a resource identity object.
+// A resource identity object is converted to the equivalent legacy import ID before calling the existing StateContextFunc
+// and the identity attributes are then set on the imported resource.
+func identityImporter(identity *types.ServicePackageResourceIdentity, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if !conns.IsImportIdentity(d.Id()) {
+			return f(ctx, d, meta)
+		}
+
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return nil, fmt.Errorf("unexpected provider meta type: %T", meta)
+		}
+
+		v, err := c.ParseImportIdentity(ctx, identity, d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(v.ID)
+
+		results, err := f(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range results {
+			for k, v := range v.Attributes {
+				if err := d.Set(k, v); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", k, err)
+				}
+			}
+		}
+
+		return results, nil
+	}
+}
+
+// identityRead returns a ReadContextFunc that verifies that a resource's identity attributes are not changed by refresh.
+func identityRead(identity *types.ServicePackageResourceIdentity, f schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		before := identityAttributeValues(identity, d)
+
+		diags := f(ctx, d, meta)
+
+		// Error or resource removed from state.
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if changed := conns.ChangedIdentityAttributes(identity, before, identityAttributeValues(identity, d)); len(changed) > 0 {
+			return sdkdiag.AppendErrorf(diags, "resource identity (%s) changed: %s", d.Id(), strings.Join(changed, ", "))
+		}
+
+		return diags
+	}
+}
+
+func identityAttributeValues(identity *types.ServicePackageResourceIdentity, d schemaResourceData) map[string]string {
+	values := make(map[string]string, len(identity.Attributes))
+
+	for _, k := range identity.Attributes {
+		values[k], _ = d.Get(k).(string)
+	}
+
+	return values
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -49,6 +52,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_call_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines record of every AWS API call is appended. " +
+					"Can also be set using the `TF_AWS_API_CALL_LOG_FILE` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -65,6 +74,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"required_tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that all taggable resources must have",
+						},
+						"resource_type": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tags to default across all resources of a type, overriding service and provider-wide default tags",
+							Elem:        defaultTagsOverrideSchema(),
+						},
+						"service": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tags to default across all resources in a service, overriding provider-wide default tags",
+							Elem:        defaultTagsOverrideSchema(),
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -87,6 +114,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
+			"endpoint_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description: "Base URL from which all service endpoints are derived, e.g. for a local AWS emulator. " +
+					"Endpoints set in the `endpoints` block take precedence. " +
+					"Unless set explicitly, `s3_use_path_style`, `skip_credentials_validation`, `skip_metadata_api_check` and `skip_region_validation` default to `true`.",
+			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -146,12 +181,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"permissions_preflight": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.PermissionsPreflightMode_Values(), false),
+				Description: "Specifies whether IAM permissions required by resources are checked during plan. " +
+					"Valid values are `warning` and `error`.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": rateLimitsSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -228,6 +271,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.TagPolicyComplianceMode_Values(), false),
+				Description: "Specifies whether planned tags are validated against the account's effective AWS Organizations tag policy. " +
+					"Valid values are `warning` and `error`.",
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -290,13 +340,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         Read,
+					interceptor: apiCallLogInterceptor{typeName: typeName},
+				},
+			}
+
+			// Regional data sources have an optional `region` argument.
+			if !names.IsGlobalService(servicePackageName) && addRegionArgument(r, false) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -366,14 +431,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewTagPolicyContext(ctx, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			var interceptors interceptorItems
+
+			// Regional resources have an optional `region` argument.
+			var region bool
+			if !names.IsGlobalService(servicePackageName) && (v.Identity == nil || !v.Identity.Global) && addRegionArgument(r, true) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+				region = true
+			}
+
+			if v := v.Permissions; v != nil {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         Create | Update,
+					interceptor: permissionsPreflightInterceptor{permissions: v},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -408,6 +493,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+				r.CustomizeDiff = requiredTagsCustomizeDiff(r.CustomizeDiff)
+			}
+
+			if _, ok := r.SchemaMap()[names.AttrTagsAll]; ok {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         Create | Update,
+					interceptor: tagPolicyInterceptor{},
+				})
+			}
+
+			// The API call log interceptor is added last so that it runs immediately before the CRUD handler.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before,
+				why:         AllOps,
+				interceptor: apiCallLogInterceptor{typeName: typeName},
+			})
+
+			if v := v.Identity; v != nil {
+				// The resource has declared its identity attributes.
+				// Ensure that the schema looks OK.
+				schemaMap := r.SchemaMap()
+				var err error
+				for _, k := range v.Attributes {
+					if v, ok := schemaMap[k]; !ok {
+						err = fmt.Errorf("no `%s` identity attribute defined in schema: %s", k, typeName)
+						break
+					} else if v.Type != schema.TypeString {
+						err = fmt.Errorf("`%s` identity attribute must be of type String: %s", k, typeName)
+						break
+					}
+				}
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+
+				if f := r.ReadWithoutTimeout; f != nil {
+					r.ReadWithoutTimeout = identityRead(v, f)
+				}
+				if r.Importer != nil && r.Importer.StateContext != nil {
+					r.Importer.StateContext = identityImporter(v, r.Importer.StateContext)
+				}
+			}
+
+			if region {
+				r.CustomizeDiff = regionCustomizeDiff(r.CustomizeDiff)
+				if r.Importer != nil && r.Importer.StateContext != nil {
+					r.Importer.StateContext = regionImporter(r.Importer.StateContext)
+				}
 			}
 
 			rs := &wrappedResource{
@@ -432,6 +567,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if v := v.Permissions; v != nil {
+				// The resource has declared the IAM actions its CRUD handlers require.
+				r.CustomizeDiff = permissionsPreflightCustomizeDiff(v, r.CustomizeDiff)
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -464,6 +603,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	return provider, nil
 }
 
+// addRegionArgument adds the per-resource `region` argument to a resource's or data source's schema.
+// It returns false if the schema already has a `region` attribute.
+func addRegionArgument(r *schema.Resource, forceNew bool) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	region := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: verify.ValidRegionName,
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = region
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = region
+	}
+
+	return true
+}
+
 // configure ensures that the provider is fully configured.
 func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -483,6 +650,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PermissionsPreflightMode:       d.Get("permissions_preflight").(string),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -491,6 +659,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
+		TagPolicyComplianceMode:        d.Get("tag_policy_compliance").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
@@ -510,18 +679,32 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.S3USEast1RegionalEndpoint = conns.NormalizeS3USEast1RegionalEndpoint(v)
 	}
 
+	if v, ok := d.Get("api_call_log_file").(string); ok && v != "" {
+		config.APICallLogFile = v
+	} else {
+		config.APICallLogFile = os.Getenv("TF_AWS_API_CALL_LOG_FILE")
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok {
+		for i, v := range v.([]interface{}) {
+			if v == nil {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, v.(map[string]interface{}))
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -533,7 +716,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		diags = append(diags, validateDefaultTags(ctx, provider, tfMap)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.DefaultTagsConfig = expandDefaultTags(ctx, tfMap)
 	}
 
 	v := d.Get("endpoints")
@@ -544,6 +732,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if v, ok := d.Get("endpoint_url").(string); ok && v != "" {
+		expandEndpointURL(d, &config, v)
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -571,6 +763,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, dx := expandRateLimits(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -605,9 +806,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume in turn, in the order listed, to obtain the credentials used to make API calls.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -731,6 +932,24 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func defaultTagsOverrideSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The service package name, e.g. `ec2`, or the resource type name, e.g. `aws_instance`.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Resource tags to default",
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -752,6 +971,59 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+// expandEndpointURL configures the provider for an AWS API implementation, such as a local emulator, served from a single base URL.
+// Every service endpoint not set in the `endpoints` block is the base URL and settings that such implementations
+// typically require default to enabled.
+func expandEndpointURL(d *schema.ResourceData, config *conns.Config, endpointURL string) {
+	for _, endpoint := range names.Endpoints() {
+		if pkg := endpoint.ProviderPackage; config.Endpoints[pkg] == "" {
+			config.Endpoints[pkg] = endpointURL
+		}
+	}
+
+	if _, ok := d.GetOkExists("s3_use_path_style"); !ok {
+		config.S3UsePathStyle = true
+	}
+	if _, ok := d.GetOkExists("skip_credentials_validation"); !ok {
+		config.SkipCredsValidation = true
+	}
+	if _, ok := d.GetOkExists("skip_region_validation"); !ok {
+		config.SkipRegionValidation = true
+	}
+	if _, null, _ := nullable.Bool(d.Get("skip_metadata_api_check").(string)).ValueBool(); null {
+		config.EC2MetadataServiceEnableState = imds.ClientDisabled
+	}
+}
+
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate of AWS API calls made to individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_concurrency": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of concurrent API calls made to the service.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The maximum number of API calls made to the service per second.",
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The provider service package name, e.g. `iam` or `route53`.",
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -849,9 +1121,124 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		defaultConfig.ServiceTags = expandDefaultTagsOverrides(ctx, v)
+	}
+
+	if v, ok := tfMap["resource_type"].([]interface{}); ok && len(v) > 0 {
+		defaultConfig.ResourceTypeTags = expandDefaultTagsOverrides(ctx, v)
+	}
+
+	if v, ok := tfMap["required_tags"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.RequiredTags = flex.ExpandStringValueSet(v)
+	}
+
 	return defaultConfig
 }
 
+func expandDefaultTagsOverrides(ctx context.Context, tfList []interface{}) map[string]tftags.KeyValueTags {
+	overrides := make(map[string]tftags.KeyValueTags)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name, ok := tfMap["name"].(string)
+
+		if !ok || name == "" {
+			continue
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+			overrides[name] = overrides[name].Merge(tftags.New(ctx, v))
+		}
+	}
+
+	return overrides
+}
+
+// validateDefaultTags validates the names in the default_tags configuration block's service and resource_type blocks.
+func validateDefaultTags(ctx context.Context, provider *schema.Provider, tfMap map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	defaultTagsPath := cty.GetAttrPath("default_tags").IndexInt(0)
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		diags = append(diags, validateDefaultTagsOverrideNames(v, defaultTagsPath.GetAttr("service"), "service package", names.ProviderPackages())...)
+	}
+
+	if v, ok := tfMap["resource_type"].([]interface{}); ok && len(v) > 0 {
+		diags = append(diags, validateDefaultTagsOverrideNames(v, defaultTagsPath.GetAttr("resource_type"), "resource type", resourceTypeNames(ctx, provider))...)
+	}
+
+	return diags
+}
+
+func validateDefaultTagsOverrideNames(tfList []interface{}, path cty.Path, kind string, validNames []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]bool)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		name := tfMap["name"].(string)
+
+		if !slices.Contains(validNames, name) {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("name"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown %s name %q.", kind, name),
+			))
+			continue
+		}
+
+		if seen[name] {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("name"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate default tags for %s %q.", kind, name),
+			))
+			continue
+		}
+
+		seen[name] = true
+	}
+
+	return diags
+}
+
+// resourceTypeNames returns the type names of all resources implemented by the provider's service packages.
+func resourceTypeNames(ctx context.Context, provider *schema.Provider) []string {
+	typeNames := tfmaps.Keys(provider.ResourcesMap)
+
+	if v, ok := provider.Meta().(*conns.AWSClient); ok {
+		for _, sp := range v.ServicePackages {
+			for _, v := range sp.FrameworkResources(ctx) {
+				r, err := v.Factory(ctx)
+
+				if err != nil {
+					continue
+				}
+
+				var response resource.MetadataResponse
+				r.Metadata(ctx, resource.MetadataRequest{}, &response)
+				typeNames = append(typeNames, response.TypeName)
+			}
+		}
+	}
+
+	return typeNames
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1257,57 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string]conns.RateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rateLimitsPath := cty.GetAttrPath("rate_limits")
+	servicePackageNames := names.ProviderPackages()
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		elementPath := rateLimitsPath.IndexInt(i)
+		servicePackageName := tfMap["service"].(string)
+
+		if !slices.Contains(servicePackageNames, servicePackageName) {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service package name %q.", servicePackageName),
+			))
+			continue
+		}
+
+		if _, ok := rateLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate rate limits for service package %q.", servicePackageName),
+			))
+			continue
+		}
+
+		rateLimit := conns.RateLimit{}
+
+		if v, ok := tfMap["max_concurrency"].(int); ok {
+			rateLimit.MaxConcurrency = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		rateLimits[servicePackageName] = rateLimit
+	}
+
+	return rateLimits, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
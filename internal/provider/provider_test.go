@@ -9,9 +9,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +63,179 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		tfList        []interface{}
+		expected      map[string]conns.RateLimit
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":             names.IAM,
+					"max_concurrency":     5,
+					"requests_per_second": 10.0,
+				},
+				map[string]interface{}{
+					"service":             names.Organizations,
+					"max_concurrency":     2,
+					"requests_per_second": 0.0,
+				},
+			},
+			expected: map[string]conns.RateLimit{
+				names.IAM:           {MaxConcurrency: 5, RequestsPerSecond: 10},
+				names.Organizations: {MaxConcurrency: 2},
+			},
+		},
+		"unknown service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":             "notaservice",
+					"max_concurrency":     5,
+					"requests_per_second": 0.0,
+				},
+			},
+			expected: map[string]conns.RateLimit{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("rate_limits").IndexInt(0).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Unknown service package name "notaservice".`,
+				),
+			},
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":             names.Route53,
+					"max_concurrency":     5,
+					"requests_per_second": 0.0,
+				},
+				map[string]interface{}{
+					"service":             names.Route53,
+					"max_concurrency":     1,
+					"requests_per_second": 0.0,
+				},
+			},
+			expected: map[string]conns.RateLimit{
+				names.Route53: {MaxConcurrency: 5},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("rate_limits").IndexInt(1).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Duplicate rate limits for service package "route53".`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandRateLimits(ctx, testCase.tfList)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandEndpointURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		raw                                map[string]interface{}
+		endpoints                          map[string]string
+		expectedDynamoDBEndpoint           string
+		expectedS3UsePathStyle             bool
+		expectedSkipCredsValidation        bool
+		expectedEC2MetadataServiceDisabled bool
+		expectedSkipRegionValidation       bool
+	}{
+		"defaults": {
+			raw: map[string]interface{}{
+				"endpoint_url": "http://localhost:4566",
+			},
+			expectedDynamoDBEndpoint:           "http://localhost:4566",
+			expectedS3UsePathStyle:             true,
+			expectedSkipCredsValidation:        true,
+			expectedEC2MetadataServiceDisabled: true,
+			expectedSkipRegionValidation:       true,
+		},
+		"exceptions": {
+			raw: map[string]interface{}{
+				"endpoint_url":                "http://localhost:4566",
+				"skip_credentials_validation": true,
+				"skip_metadata_api_check":     "false",
+			},
+			endpoints: map[string]string{
+				names.DynamoDB: "http://localhost:8000",
+			},
+			expectedDynamoDBEndpoint:     "http://localhost:8000",
+			expectedS3UsePathStyle:       true,
+			expectedSkipCredsValidation:  true,
+			expectedSkipRegionValidation: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, p.Schema, testCase.raw)
+			config := conns.Config{
+				Endpoints: make(map[string]string),
+			}
+			for k, v := range testCase.endpoints {
+				config.Endpoints[k] = v
+			}
+
+			expandEndpointURL(d, &config, d.Get("endpoint_url").(string))
+
+			if got, expected := config.Endpoints[names.DynamoDB], testCase.expectedDynamoDBEndpoint; got != expected {
+				t.Errorf("incorrect DynamoDB endpoint. Expected: %s, got: %s", expected, got)
+			}
+			if got, expected := config.Endpoints[names.STS], "http://localhost:4566"; got != expected {
+				t.Errorf("incorrect STS endpoint. Expected: %s, got: %s", expected, got)
+			}
+			if got, expected := config.S3UsePathStyle, testCase.expectedS3UsePathStyle; got != expected {
+				t.Errorf("incorrect S3UsePathStyle. Expected: %t, got: %t", expected, got)
+			}
+			if got, expected := config.SkipCredsValidation, testCase.expectedSkipCredsValidation; got != expected {
+				t.Errorf("incorrect SkipCredsValidation. Expected: %t, got: %t", expected, got)
+			}
+			if got, expected := config.SkipRegionValidation, testCase.expectedSkipRegionValidation; got != expected {
+				t.Errorf("incorrect SkipRegionValidation. Expected: %t, got: %t", expected, got)
+			}
+			if got, expected := config.EC2MetadataServiceEnableState == imds.ClientDisabled, testCase.expectedEC2MetadataServiceDisabled; got != expected {
+				t.Errorf("incorrect EC2 metadata service disabled. Expected: %t, got: %t", expected, got)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_bcmdataexports_export", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_bcmdataexports_export", &resource.Sweeper{
 		Name: "aws_bcmdataexports_export",
 		F:    sweepExports,
 	})
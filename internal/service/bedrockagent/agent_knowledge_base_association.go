@@ -30,6 +30,7 @@ import (
 )
 
 // @FrameworkResource(name="Agent Knowledge Base Association")
+// @Identity("agent_id", "agent_version", "knowledge_base_id")
 func newAgentKnowledgeBaseAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &agentKnowledgeBaseAssociationResource{}
 
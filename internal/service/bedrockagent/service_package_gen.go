@@ -34,6 +34,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newAgentKnowledgeBaseAssociationResource,
 			Name:    "Agent Knowledge Base Association",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"agent_id", "agent_version", "knowledge_base_id"},
+				Separator:  ",",
+			},
 		},
 		{
 			Factory: newAgentResource,
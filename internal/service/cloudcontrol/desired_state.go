@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// desiredStateSchema is the subset of a CloudFormation resource type schema used to compare desired states
+// and to validate resource models.
+// See https://docs.aws.amazon.com/cloudformation-cli/latest/userguide/resource-type-schema.html.
+type desiredStateSchema struct {
+	Definitions         map[string]*desiredStatePropertySchema `json:"definitions,omitempty"`
+	Properties          map[string]*desiredStatePropertySchema `json:"properties,omitempty"`
+	ReadOnlyProperties  []string                               `json:"readOnlyProperties,omitempty"`
+	WriteOnlyProperties []string                               `json:"writeOnlyProperties,omitempty"`
+}
+
+type desiredStatePropertySchema struct {
+	Default           any                                    `json:"default,omitempty"`
+	InsertionOrder    *bool                                  `json:"insertionOrder,omitempty"`
+	Items             *desiredStatePropertySchema            `json:"items,omitempty"`
+	PatternProperties map[string]any                         `json:"patternProperties,omitempty"`
+	Properties        map[string]*desiredStatePropertySchema `json:"properties,omitempty"`
+	Ref               string                                 `json:"$ref,omitempty"`
+	Type              any                                    `json:"type,omitempty"` // A type name or a list of type names.
+}
+
+func newDesiredStateSchema(resourceSchema string) (*desiredStateSchema, error) {
+	var s desiredStateSchema
+
+	if err := json.Unmarshal([]byte(resourceSchema), &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// desiredStateEqual returns whether two desired state documents are semantically equal for the resource type.
+func desiredStateEqual(resourceSchema, old, new string) (bool, error) {
+	s, err := newDesiredStateSchema(resourceSchema)
+
+	if err != nil {
+		return false, err
+	}
+
+	oldNormalized, err := s.normalize(old)
+
+	if err != nil {
+		return false, err
+	}
+
+	newNormalized, err := s.normalize(new)
+
+	if err != nil {
+		return false, err
+	}
+
+	return oldNormalized == newNormalized, nil
+}
+
+// normalize returns the canonical JSON form of a desired state document.
+// Read-only and write-only properties are removed, schema defaults are applied to unset properties
+// and arrays whose insertion order is not significant are sorted.
+func (s *desiredStateSchema) normalize(document string) (string, error) {
+	return s.normalizeRemovingPaths(document, slices.Concat(s.ReadOnlyProperties, s.WriteOnlyProperties))
+}
+
+// normalizeForUpdate returns the canonical JSON form of a desired state document from which an update's patch is created.
+// Unlike normalize, write-only properties are kept so that any change to them is applied along with other changes.
+func (s *desiredStateSchema) normalizeForUpdate(document string) (string, error) {
+	return s.normalizeRemovingPaths(document, s.ReadOnlyProperties)
+}
+
+func (s *desiredStateSchema) normalizeRemovingPaths(document string, paths []string) (string, error) {
+	var v any
+
+	if err := json.Unmarshal([]byte(document), &v); err != nil {
+		return "", err
+	}
+
+	for _, path := range paths {
+		if path, ok := strings.CutPrefix(path, "/properties/"); ok {
+			v = removeDesiredStatePath(v, strings.Split(path, "/"))
+		}
+	}
+
+	v = s.normalizeObject(v, s.Properties)
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (s *desiredStateSchema) normalizeObject(v any, properties map[string]*desiredStatePropertySchema) any {
+	m, ok := v.(map[string]any)
+
+	if !ok {
+		return v
+	}
+
+	for k, property := range properties {
+		property = s.resolve(property)
+
+		if property == nil {
+			continue
+		}
+
+		if e, ok := m[k]; ok {
+			m[k] = s.normalizeValue(e, property)
+		} else if property.Default != nil {
+			m[k] = property.Default
+		}
+	}
+
+	return m
+}
+
+func (s *desiredStateSchema) normalizeValue(v any, property *desiredStatePropertySchema) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return s.normalizeObject(v, property.Properties)
+	case []any:
+		if items := s.resolve(property.Items); items != nil {
+			for i, e := range v {
+				v[i] = s.normalizeValue(e, items)
+			}
+		}
+
+		if property.InsertionOrder != nil && !*property.InsertionOrder {
+			slices.SortStableFunc(v, func(a, b any) int {
+				x, _ := json.Marshal(a)
+				y, _ := json.Marshal(b)
+
+				return bytes.Compare(x, y)
+			})
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// resolve returns the property's referenced definition, if any.
+func (s *desiredStateSchema) resolve(property *desiredStatePropertySchema) *desiredStatePropertySchema {
+	for property != nil && property.Ref != "" {
+		name, ok := strings.CutPrefix(property.Ref, "#/definitions/")
+
+		if !ok {
+			return property
+		}
+
+		property = s.Definitions[name]
+	}
+
+	return property
+}
+
+// removeDesiredStatePath removes the value at the JSON Pointer path, relative to the document's properties.
+// A "*" segment matches all elements of an array.
+func removeDesiredStatePath(v any, path []string) any {
+	if len(path) == 0 {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			delete(v, path[0])
+		} else if e, ok := v[path[0]]; ok {
+			v[path[0]] = removeDesiredStatePath(e, path[1:])
+		}
+	case []any:
+		if path[0] == "*" {
+			for i, e := range v {
+				v[i] = removeDesiredStatePath(e, path[1:])
+			}
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"testing"
+)
+
+func TestDesiredStateEqual(t *testing.T) {
+	t.Parallel()
+
+	const resourceSchema = `{
+  "typeName": "Example::Test::Resource",
+  "definitions": {
+    "Tag": {
+      "type": "object",
+      "properties": {
+        "Key": {"type": "string"},
+        "Value": {"type": "string"}
+      }
+    },
+    "Rule": {
+      "type": "object",
+      "properties": {
+        "Enabled": {"type": "boolean", "default": true},
+        "Password": {"type": "string"}
+      }
+    }
+  },
+  "properties": {
+    "Arn": {"type": "string"},
+    "Name": {"type": "string"},
+    "Password": {"type": "string"},
+    "RetentionInDays": {"type": "integer", "default": 7},
+    "Rules": {"type": "array", "insertionOrder": true, "items": {"$ref": "#/definitions/Rule"}},
+    "Tags": {"type": "array", "insertionOrder": false, "items": {"$ref": "#/definitions/Tag"}}
+  },
+  "readOnlyProperties": ["/properties/Arn"],
+  "writeOnlyProperties": ["/properties/Password", "/properties/Rules/*/Password"]
+}`
+
+	testCases := map[string]struct {
+		old           string
+		new           string
+		expected      bool
+		expectedError bool
+	}{
+		"identical": {
+			old:      `{"Name":"test"}`,
+			new:      `{"Name":"test"}`,
+			expected: true,
+		},
+		"different value": {
+			old: `{"Name":"test1"}`,
+			new: `{"Name":"test2"}`,
+		},
+		"read-only property": {
+			old:      `{"Arn":"arn:aws:example:::test","Name":"test"}`,
+			new:      `{"Name":"test"}`,
+			expected: true,
+		},
+		"write-only property": {
+			old:      `{"Name":"test","Password":"secret1"}`,
+			new:      `{"Name":"test"}`,
+			expected: true,
+		},
+		"nested write-only property": {
+			old:      `{"Rules":[{"Enabled":false,"Password":"secret1"}]}`,
+			new:      `{"Rules":[{"Enabled":false}]}`,
+			expected: true,
+		},
+		"default": {
+			old:      `{"Name":"test","RetentionInDays":7}`,
+			new:      `{"Name":"test"}`,
+			expected: true,
+		},
+		"not default": {
+			old: `{"Name":"test","RetentionInDays":14}`,
+			new: `{"Name":"test"}`,
+		},
+		"nested default": {
+			old:      `{"Rules":[{"Enabled":true}]}`,
+			new:      `{"Rules":[{}]}`,
+			expected: true,
+		},
+		"insertion order not significant": {
+			old:      `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			new:      `{"Tags":[{"Value":"2","Key":"b"},{"Key":"a","Value":"1"}]}`,
+			expected: true,
+		},
+		"insertion order significant": {
+			old: `{"Rules":[{"Enabled":true},{"Enabled":false}]}`,
+			new: `{"Rules":[{"Enabled":false},{"Enabled":true}]}`,
+		},
+		"invalid JSON": {
+			old:           `{"Name":`,
+			new:           `{"Name":"test"}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := desiredStateEqual(resourceSchema, testCase.old, testCase.new)
+
+			if got, expected := err != nil, testCase.expectedError; got != expected {
+				t.Fatalf("expected error: %t, got: %s", expected, err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDesiredStateNormalizeForUpdate(t *testing.T) {
+	t.Parallel()
+
+	const resourceSchema = `{
+  "typeName": "Example::Test::Resource",
+  "properties": {
+    "Arn": {"type": "string"},
+    "Name": {"type": "string"},
+    "Password": {"type": "string"},
+    "Tags": {"type": "array", "insertionOrder": false, "items": {"type": "string"}}
+  },
+  "readOnlyProperties": ["/properties/Arn"],
+  "writeOnlyProperties": ["/properties/Password"]
+}`
+
+	testCases := map[string]struct {
+		document string
+		expected string
+	}{
+		"read-only property": {
+			document: `{"Arn":"arn:aws:example:::test","Name":"test"}`,
+			expected: `{"Name":"test"}`,
+		},
+		"write-only property": {
+			document: `{"Name":"test","Password":"secret1"}`,
+			expected: `{"Name":"test","Password":"secret1"}`,
+		},
+		"insertion order not significant": {
+			document: `{"Tags":["b","a"]}`,
+			expected: `{"Tags":["a","b"]}`,
+		},
+	}
+
+	s, err := newDesiredStateSchema(resourceSchema)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := s.normalizeForUpdate(testCase.document)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
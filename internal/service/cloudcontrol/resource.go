@@ -47,8 +47,9 @@ func resourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: desiredStateDiffSuppress,
 			},
 			names.AttrProperties: {
 				Type:     schema.TypeString,
@@ -165,7 +166,7 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		patchDocument, err := desiredStatePatchDocument(d.Get(names.AttrSchema).(string), oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
@@ -307,7 +308,26 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	desiredStateSchema, err := newDesiredStateSchema(newSchema)
+
+	if err != nil {
+		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	// Read-only and write-only properties, defaults and array ordering do not force replacement.
+	oldDesiredState, err := desiredStateSchema.normalize(oldDesiredStateRaw.(string))
+
+	if err != nil {
+		return fmt.Errorf("normalizing old desired_state: %w", err)
+	}
+
+	newDesiredState, err = desiredStateSchema.normalize(newDesiredState)
+
+	if err != nil {
+		return fmt.Errorf("normalizing new desired_state: %w", err)
+	}
+
+	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredState), []byte(newDesiredState))
 
 	if err != nil {
 		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
@@ -326,6 +346,24 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	return nil
 }
 
+// desiredStateDiffSuppress suppresses differences in desired_state that are not significant to the resource type's schema.
+func desiredStateDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	resourceSchema := d.Get(names.AttrSchema).(string)
+
+	if old == "" || new == "" || resourceSchema == "" {
+		return false
+	}
+
+	equal, err := desiredStateEqual(resourceSchema, old, new)
+
+	if err != nil {
+		log.Printf("[WARN] Comparing Cloud Control API Resource desired_state: %s", err)
+		return false
+	}
+
+	return equal
+}
+
 func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
 	input := &cloudcontrol.GetResourceInput{
 		Identifier: aws.String(resourceID),
@@ -429,6 +467,35 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 	return nil, err
 }
 
+// desiredStatePatchDocument returns a JSON Patch document describing the difference between the `old` and `new` desired states.
+// The desired states are first normalized using the resource type schema, so that for example
+// reordering an array whose insertion order is not significant does not result in any operations.
+func desiredStatePatchDocument(resourceSchema, old, new string) (string, error) {
+	if resourceSchema == "" {
+		return patchDocument(old, new)
+	}
+
+	s, err := newDesiredStateSchema(resourceSchema)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	old, err = s.normalizeForUpdate(old)
+
+	if err != nil {
+		return "", fmt.Errorf("normalizing old desired_state: %w", err)
+	}
+
+	new, err = s.normalizeForUpdate(new)
+
+	if err != nil {
+		return "", fmt.Errorf("normalizing new desired_state: %w", err)
+	}
+
+	return patchDocument(old, new)
+}
+
 // patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
 func patchDocument(old, new string) (string, error) {
 	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// validateResourceModel validates that the properties of a ListResources resource model
+// are properties of the CloudFormation resource type and that their values are of the properties' types.
+// Required properties are not checked as a resource model contains only the properties used to filter the list.
+func validateResourceModel(resourceSchema, resourceModel string) error {
+	s, err := newDesiredStateSchema(resourceSchema)
+
+	if err != nil {
+		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	var model map[string]any
+
+	if err := json.Unmarshal([]byte(resourceModel), &model); err != nil {
+		return fmt.Errorf("resource model must be a JSON object: %w", err)
+	}
+
+	return s.validateObject(model, s.Properties, nil)
+}
+
+func (s *desiredStateSchema) validateObject(m map[string]any, properties map[string]*desiredStatePropertySchema, path []string) error {
+	keys := tfmaps.Keys(m)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		property, ok := properties[k]
+
+		if !ok {
+			return fmt.Errorf("%q is not a property of the resource type", strings.Join(append(path, k), "/"))
+		}
+
+		if err := s.validateValue(m[k], property, append(path, k)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *desiredStateSchema) validateValue(v any, property *desiredStatePropertySchema, path []string) error {
+	property = s.resolve(property)
+
+	if property == nil {
+		return nil
+	}
+
+	if types := property.types(); len(types) > 0 && !slices.ContainsFunc(types, func(typ string) bool {
+		return valueIsOfType(v, typ)
+	}) {
+		return fmt.Errorf("%q must be of type %s, got %s", strings.Join(path, "/"), strings.Join(types, " or "), valueType(v))
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		// Objects with pattern properties may have properties with any matching name.
+		if len(property.Properties) > 0 && len(property.PatternProperties) == 0 {
+			return s.validateObject(v, property.Properties, path)
+		}
+	case []any:
+		if property.Items != nil {
+			for i, e := range v {
+				if err := s.validateValue(e, property.Items, append(path, strconv.Itoa(i))); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// types returns the property's JSON Schema type names.
+func (p *desiredStatePropertySchema) types() []string {
+	switch v := p.Type.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var types []string
+		for _, e := range v {
+			if e, ok := e.(string); ok {
+				types = append(types, e)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+func valueIsOfType(v any, typ string) bool {
+	switch t := valueType(v); typ {
+	case "number":
+		return t == "number" || t == "integer"
+	default:
+		return t == typ
+	}
+}
+
+// valueType returns the JSON Schema type name of a decoded JSON value.
+func valueType(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
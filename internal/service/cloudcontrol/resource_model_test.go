@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"testing"
+)
+
+func TestValidateResourceModel(t *testing.T) {
+	t.Parallel()
+
+	const resourceSchema = `{
+  "typeName": "Example::Test::Resource",
+  "definitions": {
+    "Tag": {
+      "type": "object",
+      "properties": {
+        "Key": {"type": "string"},
+        "Value": {"type": "string"}
+      },
+      "additionalProperties": false
+    }
+  },
+  "properties": {
+    "Name": {"type": "string"},
+    "Enabled": {"type": "boolean"},
+    "RetentionInDays": {"type": "integer"},
+    "Ratio": {"type": "number"},
+    "Policy": {"type": ["object", "string"]},
+    "Labels": {"type": "object", "patternProperties": {"^[a-z]+$": {"type": "string"}}},
+    "Tags": {"type": "array", "items": {"$ref": "#/definitions/Tag"}}
+  }
+}`
+
+	testCases := map[string]struct {
+		resourceModel string
+		expectedError bool
+	}{
+		"empty": {
+			resourceModel: `{}`,
+		},
+		"valid": {
+			resourceModel: `{"Name":"test","Enabled":true,"RetentionInDays":7,"Ratio":0.5,"Tags":[{"Key":"k","Value":"v"}]}`,
+		},
+		"integer is number": {
+			resourceModel: `{"Ratio":1}`,
+		},
+		"multiple types": {
+			resourceModel: `{"Policy":"{}"}`,
+		},
+		"pattern properties": {
+			resourceModel: `{"Labels":{"any":"value"}}`,
+		},
+		"unknown property": {
+			resourceModel: `{"InvalidName":"test"}`,
+			expectedError: true,
+		},
+		"unknown nested property": {
+			resourceModel: `{"Tags":[{"Key":"k","InvalidName":"v"}]}`,
+			expectedError: true,
+		},
+		"string not integer": {
+			resourceModel: `{"RetentionInDays":"7"}`,
+			expectedError: true,
+		},
+		"number not integer": {
+			resourceModel: `{"RetentionInDays":7.5}`,
+			expectedError: true,
+		},
+		"object not array": {
+			resourceModel: `{"Tags":{"Key":"k"}}`,
+			expectedError: true,
+		},
+		"nested type": {
+			resourceModel: `{"Tags":[{"Key":1}]}`,
+			expectedError: true,
+		},
+		"not an object": {
+			resourceModel: `[]`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateResourceModel(resourceSchema, testCase.resourceModel)
+
+			if got, expected := err != nil, testCase.expectedError; got != expected {
+				t.Errorf("expected error: %t, got: %v", expected, err)
+			}
+		})
+	}
+}
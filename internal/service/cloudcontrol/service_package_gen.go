@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
 		},
+		{
+			Factory:  dataSourceResources,
+			TypeName: "aws_cloudcontrolapi_resources",
+			Name:     "Resources",
+		},
 	}
 }
 
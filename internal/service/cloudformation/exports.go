@@ -5,10 +5,11 @@ package cloudformation
 
 // Exports for use in other modules.
 var (
-	FindStackByName      = findStackByName
-	FindTypeByName       = findTypeByName
-	WaitChangeSetCreated = waitChangeSetCreated
-	WaitStackCreated     = waitStackCreated
-	WaitStackDeleted     = waitStackDeleted
-	WaitStackUpdated     = waitStackUpdated
+	FindStackByName            = findStackByName
+	FindTypeByName             = findTypeByName
+	FindTypeByNameAndVersionID = findTypeByNameAndVersionID
+	WaitChangeSetCreated       = waitChangeSetCreated
+	WaitStackCreated           = waitStackCreated
+	WaitStackDeleted           = waitStackDeleted
+	WaitStackUpdated           = waitStackUpdated
 )
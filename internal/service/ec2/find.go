@@ -8,19 +8,46 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/batcher"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Concurrent finds by ID made by resource Read within this window are coalesced into a single Describe call.
+	findBatchWindow = 25 * time.Millisecond
+	// The maximum number of values in an EC2 API filter.
+	findBatchMaxSize = 200
+)
+
+// findBatcher returns the AWSClient's batcher with the specified name for coalescing finds by ID, creating it if necessary.
+// Coalescing adds up to findBatchWindow of latency to each find, so it is only used when resources are read,
+// e.g. during refresh, and not when waiting for a resource's status to change.
+func findBatcher[V any](ctx context.Context, c *conns.AWSClient, name string, lookup func(context.Context, *ec2.Client, []string) (map[string]V, error)) *batcher.Batcher[string, V] {
+	conn := c.EC2Client(ctx)
+
+	return conns.Batcher(ctx, c, name, func(ctx context.Context, ids []string) (map[string]V, error) {
+		// The lookup is made in the Context of the first find in the batch, so attribute it to all the finds.
+		ctx = tflog.SetField(ctx, logging.KeyResourceId, ids)
+		ctx = conns.NewAPICallLogBatchContext(ctx, ids)
+
+		return lookup(ctx, conn, ids)
+	}, findBatchWindow, findBatchMaxSize)
+}
+
 func findAvailabilityZones(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAvailabilityZonesInput) ([]awstypes.AvailabilityZone, error) {
 	output, err := conn.DescribeAvailabilityZones(ctx, input)
 
@@ -1280,6 +1307,46 @@ func findSubnetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes
 	return output, nil
 }
 
+// findSubnetByIDBatched is findSubnetByID for resource Read, coalescing concurrent finds into a single Describe call.
+func findSubnetByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.Subnet, error) {
+	output, ok, err := findBatcher(ctx, c, "subnet", findSubnetsByIDs).Get(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, tfresource.NewEmptyResultError(&ec2.DescribeSubnetsInput{
+			SubnetIds: []string{id},
+		})
+	}
+
+	return &output, nil
+}
+
+// findSubnetsByIDs returns the subnets with the specified IDs, keyed by ID.
+// IDs that are not found are omitted.
+func findSubnetsByIDs(ctx context.Context, conn *ec2.Client, ids []string) (map[string]awstypes.Subnet, error) {
+	input := &ec2.DescribeSubnetsInput{
+		Filters: []awstypes.Filter{
+			newFilter("subnet-id", ids),
+		},
+	}
+
+	output, err := findSubnets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]awstypes.Subnet, len(output))
+	for _, v := range output {
+		m[aws.ToString(v.SubnetId)] = v
+	}
+
+	return m, nil
+}
+
 func findSubnet(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSubnetsInput) (*awstypes.Subnet, error) {
 	output, err := findSubnets(ctx, conn, input)
 
@@ -1938,6 +2005,46 @@ func findSecurityGroupByID(ctx context.Context, conn *ec2.Client, id string) (*a
 	return output, nil
 }
 
+// findSecurityGroupByIDBatched is findSecurityGroupByID for resource Read, coalescing concurrent finds into a single Describe call.
+func findSecurityGroupByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.SecurityGroup, error) {
+	output, ok, err := findBatcher(ctx, c, "security-group", findSecurityGroupsByIDs).Get(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, tfresource.NewEmptyResultError(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: []string{id},
+		})
+	}
+
+	return &output, nil
+}
+
+// findSecurityGroupsByIDs returns the security groups with the specified IDs, keyed by ID.
+// IDs that are not found are omitted.
+func findSecurityGroupsByIDs(ctx context.Context, conn *ec2.Client, ids []string) (map[string]awstypes.SecurityGroup, error) {
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: []awstypes.Filter{
+			newFilter("group-id", ids),
+		},
+	}
+
+	output, err := findSecurityGroups(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]awstypes.SecurityGroup, len(output))
+	for _, v := range output {
+		m[aws.ToString(v.GroupId)] = v
+	}
+
+	return m, nil
+}
+
 func findSecurityGroupByDescriptionAndVPCID(ctx context.Context, conn *ec2.Client, description, vpcID string) (*awstypes.SecurityGroup, error) {
 	input := &ec2.DescribeSecurityGroupsInput{
 		Filters: newAttributeFilterList(
@@ -2562,6 +2669,46 @@ func findRouteTableByID(ctx context.Context, conn *ec2.Client, routeTableID stri
 	return findRouteTable(ctx, conn, input)
 }
 
+// findRouteTableByIDBatched is findRouteTableByID for resource Read, coalescing concurrent finds into a single Describe call.
+func findRouteTableByIDBatched(ctx context.Context, c *conns.AWSClient, routeTableID string) (*awstypes.RouteTable, error) {
+	output, ok, err := findBatcher(ctx, c, "route-table", findRouteTablesByIDs).Get(ctx, routeTableID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, tfresource.NewEmptyResultError(&ec2.DescribeRouteTablesInput{
+			RouteTableIds: []string{routeTableID},
+		})
+	}
+
+	return &output, nil
+}
+
+// findRouteTablesByIDs returns the route tables with the specified IDs, keyed by ID.
+// IDs that are not found are omitted.
+func findRouteTablesByIDs(ctx context.Context, conn *ec2.Client, ids []string) (map[string]awstypes.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: []awstypes.Filter{
+			newFilter("route-table-id", ids),
+		},
+	}
+
+	output, err := findRouteTables(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]awstypes.RouteTable, len(output))
+	for _, v := range output {
+		m[aws.ToString(v.RouteTableId)] = v
+	}
+
+	return m, nil
+}
+
 // routeFinder returns the route corresponding to the specified destination.
 // Returns NotFoundError if no route is found.
 type routeFinder func(context.Context, *ec2.Client, string, string) (*awstypes.Route, error)
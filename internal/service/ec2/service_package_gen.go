@@ -1082,6 +1082,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"ec2:CreateSecurityGroup"},
+				Read:   []string{"ec2:DescribeSecurityGroups"},
+				Update: []string{"ec2:AuthorizeSecurityGroupIngress", "ec2:AuthorizeSecurityGroupEgress", "ec2:RevokeSecurityGroupIngress", "ec2:RevokeSecurityGroupEgress"},
+				Delete: []string{"ec2:DeleteSecurityGroup"},
+			},
 		},
 		{
 			Factory:  resourceSecurityGroupRule,
@@ -1121,6 +1127,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"ec2:CreateSubnet"},
+				Read:   []string{"ec2:DescribeSubnets"},
+				Update: []string{"ec2:ModifySubnetAttribute"},
+				Delete: []string{"ec2:DeleteSubnet"},
+			},
 		},
 		{
 			Factory:  resourceVerifiedAccessEndpoint,
@@ -1176,6 +1188,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"ec2:CreateVpc"},
+				Read:   []string{"ec2:DescribeVpcs", "ec2:DescribeVpcAttribute", "ec2:DescribeNetworkAcls", "ec2:DescribeRouteTables", "ec2:DescribeSecurityGroups"},
+				Update: []string{"ec2:ModifyVpcAttribute"},
+				Delete: []string{"ec2:DeleteVpc"},
+			},
 		},
 		{
 			Factory:  resourceVPCDHCPOptions,
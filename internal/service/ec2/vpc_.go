@@ -39,6 +39,10 @@ const (
 
 // @SDKResource("aws_vpc", name="VPC")
 // @Tags(identifierAttribute="id")
+// @Permissions("create", "ec2:CreateVpc")
+// @Permissions("read", "ec2:DescribeVpcs", "ec2:DescribeVpcAttribute", "ec2:DescribeNetworkAcls", "ec2:DescribeRouteTables", "ec2:DescribeSecurityGroups")
+// @Permissions("update", "ec2:ModifyVpcAttribute")
+// @Permissions("delete", "ec2:DeleteVpc")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Vpc")
 func resourceVPC() *schema.Resource {
 	//lintignore:R011
@@ -36,6 +36,10 @@ import (
 
 // @SDKResource("aws_security_group", name="Security Group")
 // @Tags(identifierAttribute="id")
+// @Permissions("create", "ec2:CreateSecurityGroup")
+// @Permissions("read", "ec2:DescribeSecurityGroups")
+// @Permissions("update", "ec2:AuthorizeSecurityGroupIngress", "ec2:AuthorizeSecurityGroupEgress", "ec2:RevokeSecurityGroupIngress", "ec2:RevokeSecurityGroupEgress")
+// @Permissions("delete", "ec2:DeleteSecurityGroup")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.SecurityGroup")
 // @Testing(importIgnore="revoke_rules_on_delete")
 func resourceSecurityGroup() *schema.Resource {
@@ -270,9 +274,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
-
-	sg, err := findSecurityGroupByID(ctx, conn, d.Id())
+	sg, err := findSecurityGroupByIDBatched(ctx, meta.(*conns.AWSClient), d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", d.Id())
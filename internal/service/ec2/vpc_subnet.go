@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_subnet", name="Subnet")
 // @Tags(identifierAttribute="id")
+// @Permissions("create", "ec2:CreateSubnet")
+// @Permissions("read", "ec2:DescribeSubnets")
+// @Permissions("update", "ec2:ModifySubnetAttribute")
+// @Permissions("delete", "ec2:DeleteSubnet")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.Subnet")
 func resourceSubnet() *schema.Resource {
 	//lintignore:R011
@@ -230,10 +234,9 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return findSubnetByID(ctx, conn, d.Id())
+		return findSubnetByIDBatched(ctx, meta.(*conns.AWSClient), d.Id())
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
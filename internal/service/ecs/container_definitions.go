@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// CanonicalContainerDefinitions returns the canonical JSON form of the specified container definitions,
+// either a JSON array of container definitions or a single container definition.
+// Unknown fields and missing required fields are errors.
+// The same defaults and ordering as are used when comparing container definitions for equivalence are applied,
+// so the containers are sorted by name, and the result uses the ECS API's field names with unset fields omitted.
+func CanonicalContainerDefinitions(s string) (string, error) {
+	s = string(bytes.TrimSpace([]byte(s)))
+	if len(s) > 0 && s[0] == '{' {
+		s = "[" + s + "]"
+	}
+
+	var apiObjects []awstypes.ContainerDefinition
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&apiObjects); err != nil {
+		return "", fmt.Errorf("decoding container definitions: %w", err)
+	}
+
+	if err := validateContainerDefinitions(apiObjects); err != nil {
+		return "", err
+	}
+
+	containerDefinitions(apiObjects).reduce(false)
+
+	v, _ := apiJSONValue(reflect.ValueOf(apiObjects))
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding container definitions: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// validateContainerDefinitions validates the fields required by the ECS API's RegisterTaskDefinition operation.
+func validateContainerDefinitions(apiObjects []awstypes.ContainerDefinition) error {
+	if len(apiObjects) == 0 {
+		return errors.New("at least one container definition is required")
+	}
+
+	var errs []error
+	names := make(map[string]bool)
+	essential := false
+
+	for _, apiObject := range apiObjects {
+		if name := aws.ToString(apiObject.Name); name != "" {
+			if names[name] {
+				errs = append(errs, fmt.Errorf("duplicate container definition name %q", name))
+			}
+			names[name] = true
+		}
+	}
+
+	for i, apiObject := range apiObjects {
+		id := fmt.Sprintf("container definition (%d)", i)
+		if name := aws.ToString(apiObject.Name); name != "" {
+			id = fmt.Sprintf("container definition %q", name)
+		} else {
+			errs = append(errs, fmt.Errorf("%s: name is required", id))
+		}
+
+		if aws.ToString(apiObject.Image) == "" {
+			errs = append(errs, fmt.Errorf("%s: image is required", id))
+		}
+
+		if apiObject.Essential == nil || aws.ToBool(apiObject.Essential) {
+			essential = true
+		}
+
+		for j, v := range apiObject.DependsOn {
+			if name := aws.ToString(v.ContainerName); !names[name] {
+				errs = append(errs, fmt.Errorf("%s: dependsOn (%d): container %q is not defined", id, j, name))
+			}
+			if v.Condition == "" {
+				errs = append(errs, fmt.Errorf("%s: dependsOn (%d): condition is required", id, j))
+			}
+		}
+
+		for j, v := range apiObject.Environment {
+			if aws.ToString(v.Name) == "" {
+				errs = append(errs, fmt.Errorf("%s: environment (%d): name is required", id, j))
+			}
+		}
+
+		for j, v := range apiObject.PortMappings {
+			if v.ContainerPort == nil && v.ContainerPortRange == nil {
+				errs = append(errs, fmt.Errorf("%s: portMappings (%d): containerPort or containerPortRange is required", id, j))
+			}
+		}
+
+		for j, v := range apiObject.Secrets {
+			if aws.ToString(v.Name) == "" || aws.ToString(v.ValueFrom) == "" {
+				errs = append(errs, fmt.Errorf("%s: secrets (%d): name and valueFrom are required", id, j))
+			}
+		}
+	}
+
+	if !essential {
+		errs = append(errs, errors.New("at least one container definition must be essential"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// optionalValueFields are the optional fields of ECS API types that are not pointers.
+// These fields are unset when they have their zero value.
+var optionalValueFields = map[reflect.Type][]string{
+	reflect.TypeFor[awstypes.ContainerDefinition](): {"Cpu"},
+}
+
+// apiJSONValue returns the JSON-encodable value of an ECS API type using the API's field names.
+// Unset fields are omitted. Fields that are set to their zero value, e.g. an empty string, are kept.
+func apiJSONValue(v reflect.Value) (any, bool) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			return nil, false
+		}
+
+		return apiJSONValue(v.Elem())
+	case reflect.Struct:
+		m := make(map[string]any)
+		t := v.Type()
+
+		for i := range t.NumField() {
+			f := t.Field(i)
+
+			if !f.IsExported() {
+				continue
+			}
+
+			fv := v.Field(i)
+
+			// Enumeration values are strings that are empty when unset.
+			if fv.IsZero() && (f.Type.Kind() == reflect.String || slices.Contains(optionalValueFields[t], f.Name)) {
+				continue
+			}
+
+			if v, ok := apiJSONValue(fv); ok {
+				m[lowerFirst(f.Name)] = v
+			}
+		}
+
+		return m, true
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return nil, false
+		}
+
+		s := make([]any, 0, v.Len())
+
+		for i := range v.Len() {
+			if v, ok := apiJSONValue(v.Index(i)); ok {
+				s = append(s, v)
+			}
+		}
+
+		return s, true
+	case reflect.Map:
+		if v.Len() == 0 {
+			return nil, false
+		}
+
+		m := make(map[string]any, v.Len())
+
+		for iter := v.MapRange(); iter.Next(); {
+			if v, ok := apiJSONValue(iter.Value()); ok {
+				m[iter.Key().String()] = v
+			}
+		}
+
+		return m, true
+	case reflect.String:
+		return v.String(), true
+	default:
+		return v.Interface(), true
+	}
+}
+
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[n:]
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+)
+
+func TestCanonicalContainerDefinitions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		"invalid JSON": {
+			input:       `[`,
+			expectedErr: true,
+		},
+		"empty": {
+			input:       `[]`,
+			expectedErr: true,
+		},
+		"unknown field": {
+			input:       `[{"name": "web", "imag": "nginx"}]`,
+			expectedErr: true,
+		},
+		"missing image": {
+			input:       `[{"name": "web"}]`,
+			expectedErr: true,
+		},
+		"duplicate name": {
+			input:       `[{"name": "web", "image": "nginx"}, {"name": "web", "image": "nginx"}]`,
+			expectedErr: true,
+		},
+		"undefined dependency": {
+			input:       `[{"name": "web", "image": "nginx", "dependsOn": [{"containerName": "app", "condition": "START"}]}]`,
+			expectedErr: true,
+		},
+		"no essential container": {
+			input:       `[{"name": "web", "image": "nginx", "essential": false}]`,
+			expectedErr: true,
+		},
+		"single object": {
+			input:    `{"name": "web", "image": "nginx"}`,
+			expected: `[{"essential":true,"image":"nginx","name":"web"}]`,
+		},
+		"defaults and ordering": {
+			input: `[
+  {
+    "name": "web",
+    "image": "nginx",
+    "cpu": 10,
+    "memory": 512,
+    "essential": true,
+    "portMappings": [{"containerPort": 80, "hostPort": 0, "protocol": "tcp"}],
+    "environment": [{"name": "B", "value": "2"}, {"name": "A", "value": "1"}],
+    "mountPoints": [],
+    "dockerLabels": {"com.example.Label": "value"}
+  },
+  {
+    "name": "app",
+    "image": "app",
+    "secrets": [{"name": "TOKEN", "valueFrom": "/example/token"}]
+  }
+]`,
+			expected: `[{"essential":true,"image":"app","name":"app","secrets":[{"name":"TOKEN","valueFrom":"/example/token"}]},{"cpu":10,"dockerLabels":{"com.example.Label":"value"},"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx","memory":512,"name":"web","portMappings":[{"containerPort":80}]}]`,
+		},
+		"empty values": {
+			input:    `[{"name": "web", "image": "nginx", "memory": 0, "environment": [{"name": "A", "value": ""}], "workingDirectory": ""}]`,
+			expected: `[{"environment":[{"name":"A","value":""}],"essential":true,"image":"nginx","memory":0,"name":"web","workingDirectory":""}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CanonicalContainerDefinitions(testCase.input)
+
+			if got, expected := err != nil, testCase.expectedErr; got != expected {
+				t.Fatalf("incorrect error. Expected: %t, got: %t (%v)", expected, got, err)
+			}
+
+			if got, expected := got, testCase.expected; got != expected {
+				t.Errorf("unexpected result.\nExpected: %s\nGot:      %s", expected, got)
+			}
+		})
+	}
+}
@@ -361,6 +361,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Version = aws.String(v.(string))
 	}
 
+	// A deterministic client request token makes a repeated create request, e.g. after an interrupted apply, idempotent.
+	clientRequestToken, err := tfresource.CreateToken(input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Cluster (%s): %s", name, err)
+	}
+	input.ClientRequestToken = aws.String(clientRequestToken)
+
 	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
 			return conn.CreateCluster(ctx, input)
@@ -395,7 +402,23 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating EKS Cluster (%s): %s", name, err)
+		// An earlier apply may have been interrupted while waiting for the cluster to be created.
+		var cluster *types.Cluster
+		cluster, err = tfresource.ResumeCreate(ctx, err,
+			errs.IsA[*types.ResourceInUseException],
+			func(ctx context.Context) (*types.Cluster, error) {
+				return findClusterByName(ctx, conn, name)
+			},
+			func(v *types.Cluster) bool {
+				return clusterCreateInProgress(v, input)
+			},
+		)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EKS Cluster (%s): %s", name, err)
+		}
+
+		outputRaw = &eks.CreateClusterOutput{Cluster: cluster}
 	}
 
 	d.SetId(aws.ToString(outputRaw.(*eks.CreateClusterOutput).Cluster.Name))
@@ -407,6 +430,15 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// clusterCreateInProgress returns whether the cluster is still being created with the same IAM role and Kubernetes version as the create request.
+func clusterCreateInProgress(v *types.Cluster, input *eks.CreateClusterInput) bool {
+	if v.Status != types.ClusterStatusCreating || aws.ToString(v.RoleArn) != aws.ToString(input.RoleArn) {
+		return false
+	}
+
+	return input.Version == nil || aws.ToString(v.Version) == aws.ToString(input.Version)
+}
+
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EKSClient(ctx)
@@ -591,7 +591,23 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ElastiCache Replication Group (%s): %s", replicationGroupID, err)
+		// An earlier apply may have been interrupted while waiting for the replication group to be created.
+		var rg *awstypes.ReplicationGroup
+		rg, err = tfresource.ResumeCreate(ctx, err,
+			errs.IsA[*awstypes.ReplicationGroupAlreadyExistsFault],
+			func(ctx context.Context) (*awstypes.ReplicationGroup, error) {
+				return findReplicationGroupByID(ctx, conn, replicationGroupID)
+			},
+			func(v *awstypes.ReplicationGroup) bool {
+				return replicationGroupCreateInProgress(v, input)
+			},
+		)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ElastiCache Replication Group (%s): %s", replicationGroupID, err)
+		}
+
+		output = &elasticache.CreateReplicationGroupOutput{ReplicationGroup: rg}
 	}
 
 	d.SetId(aws.ToString(output.ReplicationGroup.ReplicationGroupId))
@@ -1214,6 +1230,16 @@ func decreaseReplicationGroupReplicaCount(ctx context.Context, conn *elasticache
 	return nil
 }
 
+// replicationGroupCreateInProgress returns whether the replication group is still being created with the same description
+// and node type as the create request.
+func replicationGroupCreateInProgress(v *awstypes.ReplicationGroup, input *elasticache.CreateReplicationGroupInput) bool {
+	if aws.ToString(v.Status) != replicationGroupStatusCreating || aws.ToString(v.Description) != aws.ToString(input.ReplicationGroupDescription) {
+		return false
+	}
+
+	return input.CacheNodeType == nil || aws.ToString(v.CacheNodeType) == aws.ToString(input.CacheNodeType)
+}
+
 func findReplicationGroupByID(ctx context.Context, conn *elasticache.Client, id string) (*awstypes.ReplicationGroup, error) {
 	input := &elasticache.DescribeReplicationGroupsInput{
 		ReplicationGroupId: aws.String(id),
@@ -24,6 +24,10 @@ import (
 )
 
 // @SDKResource("aws_iam_group", name="Group")
+// @Permissions("create", "iam:CreateGroup")
+// @Permissions("read", "iam:GetGroup")
+// @Permissions("update", "iam:UpdateGroup")
+// @Permissions("delete", "iam:DeleteGroup")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
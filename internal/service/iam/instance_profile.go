@@ -33,6 +33,10 @@ const (
 
 // @SDKResource("aws_iam_instance_profile", name="Instance Profile")
 // @Tags(identifierAttribute="id", resourceType="InstanceProfile")
+// @Permissions("create", "iam:CreateInstanceProfile")
+// @Permissions("read", "iam:GetInstanceProfile")
+// @Permissions("update", "iam:AddRoleToInstanceProfile", "iam:RemoveRoleFromInstanceProfile")
+// @Permissions("delete", "iam:DeleteInstanceProfile")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.InstanceProfile")
 func resourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
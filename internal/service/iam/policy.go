@@ -36,6 +36,10 @@ const (
 
 // @SDKResource("aws_iam_policy", name="Policy")
 // @Tags(identifierAttribute="id", resourceType="Policy")
+// @Permissions("create", "iam:CreatePolicy")
+// @Permissions("read", "iam:GetPolicy", "iam:GetPolicyVersion")
+// @Permissions("update", "iam:ListPolicyVersions", "iam:CreatePolicyVersion", "iam:DeletePolicyVersion")
+// @Permissions("delete", "iam:ListPolicyVersions", "iam:DeletePolicyVersion", "iam:DeletePolicy")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
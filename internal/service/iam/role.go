@@ -41,6 +41,10 @@ const (
 
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags(identifierAttribute="id", resourceType="Role")
+// @Permissions("create", "iam:CreateRole")
+// @Permissions("read", "iam:GetRole", "iam:ListRolePolicies", "iam:ListAttachedRolePolicies")
+// @Permissions("update", "iam:UpdateAssumeRolePolicy", "iam:UpdateRole")
+// @Permissions("delete", "iam:ListInstanceProfilesForRole", "iam:DeleteRole")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
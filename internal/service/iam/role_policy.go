@@ -32,6 +32,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role_policy", name="Role Policy")
+// @Identity("role", "name", global=true, separator=":")
 func resourceRolePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyPut,
@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_iam_role_policy_attachment", name="Role Policy Attachment")
+// @Permissions("create", "iam:AttachRolePolicy")
+// @Permissions("read", "iam:ListAttachedRolePolicies")
+// @Permissions("delete", "iam:DetachRolePolicy")
+// @Identity("role", "policy_arn", global=true, separator="/")
 func resourceRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyAttachmentCreate,
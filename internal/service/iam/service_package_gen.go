@@ -137,6 +137,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceGroup,
 			TypeName: "aws_iam_group",
 			Name:     "Group",
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"iam:CreateGroup"},
+				Read:   []string{"iam:GetGroup"},
+				Update: []string{"iam:UpdateGroup"},
+				Delete: []string{"iam:DeleteGroup"},
+			},
 		},
 		{
 			Factory:  resourceGroupMembership,
@@ -161,6 +167,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "InstanceProfile",
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"iam:CreateInstanceProfile"},
+				Read:   []string{"iam:GetInstanceProfile"},
+				Update: []string{"iam:AddRoleToInstanceProfile", "iam:RemoveRoleFromInstanceProfile"},
+				Delete: []string{"iam:DeleteInstanceProfile"},
+			},
 		},
 		{
 			Factory:  resourceOpenIDConnectProvider,
@@ -179,6 +191,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Policy",
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"iam:CreatePolicy"},
+				Read:   []string{"iam:GetPolicy", "iam:GetPolicyVersion"},
+				Update: []string{"iam:ListPolicyVersions", "iam:CreatePolicyVersion", "iam:DeletePolicyVersion"},
+				Delete: []string{"iam:ListPolicyVersions", "iam:DeletePolicyVersion", "iam:DeletePolicy"},
+			},
 		},
 		{
 			Factory:  resourcePolicyAttachment,
@@ -193,16 +211,37 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Role",
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"iam:CreateRole"},
+				Read:   []string{"iam:GetRole", "iam:ListRolePolicies", "iam:ListAttachedRolePolicies"},
+				Update: []string{"iam:UpdateAssumeRolePolicy", "iam:UpdateRole"},
+				Delete: []string{"iam:ListInstanceProfilesForRole", "iam:DeleteRole"},
+			},
 		},
 		{
 			Factory:  resourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
 			Name:     "Role Policy",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"role", "name"},
+				Global:     true,
+				Separator:  ":",
+			},
 		},
 		{
 			Factory:  resourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"iam:AttachRolePolicy"},
+				Read:   []string{"iam:ListAttachedRolePolicies"},
+				Delete: []string{"iam:DetachRolePolicy"},
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"role", "policy_arn"},
+				Global:     true,
+				Separator:  "/",
+			},
 		},
 		{
 			Factory:  resourceSAMLProvider,
@@ -254,6 +293,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "User",
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"iam:CreateUser"},
+				Read:   []string{"iam:GetUser"},
+				Update: []string{"iam:UpdateUser"},
+				Delete: []string{"iam:DeleteUser"},
+			},
 		},
 		{
 			Factory:  resourceUserGroupMembership,
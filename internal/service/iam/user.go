@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_iam_user", name="User")
 // @Tags(identifierAttribute="id", resourceType="User")
+// @Permissions("create", "iam:CreateUser")
+// @Permissions("read", "iam:GetUser")
+// @Permissions("update", "iam:UpdateUser")
+// @Permissions("delete", "iam:DeleteUser")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.User", importIgnore="force_destroy")
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
@@ -28,6 +28,7 @@ import (
 const lfTagsValuesMaxBatchSize = 50
 
 // @SDKResource("aws_lakeformation_lf_tag")
+// @Identity("catalog_id", "key", separator=":")
 func ResourceLFTag() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLFTagCreate,
@@ -57,6 +57,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"catalog_id", "key"},
+				Separator:  ":",
+			},
 		},
 		{
 			Factory:  ResourcePermissions,
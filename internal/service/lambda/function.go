@@ -42,6 +42,10 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @Permissions("create", "lambda:CreateFunction", "iam:PassRole")
+// @Permissions("read", "lambda:GetFunction", "lambda:ListVersionsByFunction")
+// @Permissions("update", "lambda:UpdateFunctionConfiguration", "lambda:UpdateFunctionCode")
+// @Permissions("delete", "lambda:DeleteFunction")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
 func resourceFunction() *schema.Resource {
@@ -91,6 +91,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"lambda:CreateFunction", "iam:PassRole"},
+				Read:   []string{"lambda:GetFunction", "lambda:ListVersionsByFunction"},
+				Update: []string{"lambda:UpdateFunctionConfiguration", "lambda:UpdateFunctionCode"},
+				Delete: []string{"lambda:DeleteFunction"},
+			},
 		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
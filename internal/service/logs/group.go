@@ -27,6 +27,10 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags(identifierAttribute="arn")
+// @Permissions("create", "logs:CreateLogGroup")
+// @Permissions("read", "logs:DescribeLogGroups")
+// @Permissions("update", "logs:PutRetentionPolicy")
+// @Permissions("delete", "logs:DeleteLogGroup")
 // @Testing(destroyTakesT=true)
 // @Testing(existsTakesT=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types;awstypes;awstypes.LogGroup")
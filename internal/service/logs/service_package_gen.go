@@ -73,6 +73,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"logs:CreateLogGroup"},
+				Read:   []string{"logs:DescribeLogGroups"},
+				Update: []string{"logs:PutRetentionPolicy"},
+				Delete: []string{"logs:DeleteLogGroup"},
+			},
 		},
 		{
 			Factory:  resourceMetricFilter,
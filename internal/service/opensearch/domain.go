@@ -641,9 +641,7 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	// so w/out this check Create would act as upsert
 	// and might cause duplicate domain to appear in state
 	resp, err := FindDomainByName(ctx, conn, d.Get(names.AttrDomainName).(string))
-	if err == nil {
-		return sdkdiag.AppendErrorf(diags, "OpenSearch Domain %q already exists", aws.StringValue(resp.DomainName))
-	}
+	exists := err == nil
 
 	input := &opensearchservice.CreateDomainInput{
 		DomainName: aws.String(d.Get(names.AttrDomainName).(string)),
@@ -776,13 +774,24 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (any, error) {
-		return conn.CreateDomainWithContext(ctx, input)
-	},
-		domainErrorRetryable)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Domain: %s", err)
+	var outputRaw any
+	if exists {
+		// An earlier apply may have been interrupted while waiting for the domain to be created.
+		if !domainCreateInProgress(resp, input) {
+			return sdkdiag.AppendErrorf(diags, "OpenSearch Domain %q already exists", aws.StringValue(resp.DomainName))
+		}
+
+		log.Printf("[INFO] Resuming create of OpenSearch Domain %q", aws.StringValue(resp.DomainName))
+		outputRaw = &opensearchservice.CreateDomainOutput{DomainStatus: resp}
+	} else {
+		// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
+		outputRaw, err = tfresource.RetryWhen(ctx, propagationTimeout, func() (any, error) {
+			return conn.CreateDomainWithContext(ctx, input)
+		},
+			domainErrorRetryable)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating OpenSearch Domain: %s", err)
+		}
 	}
 	out := outputRaw.(*opensearchservice.CreateDomainOutput)
 
@@ -1143,6 +1152,24 @@ func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// domainCreateInProgress returns whether the domain is still being created with the same engine version and
+// instance type as the create request.
+func domainCreateInProgress(ds *opensearchservice.DomainStatus, input *opensearchservice.CreateDomainInput) bool {
+	if aws.BoolValue(ds.Deleted) || !aws.BoolValue(ds.Processing) || ds.Endpoint != nil || ds.Endpoints != nil {
+		return false
+	}
+
+	if input.EngineVersion != nil && aws.StringValue(ds.EngineVersion) != aws.StringValue(input.EngineVersion) {
+		return false
+	}
+
+	if v := input.ClusterConfig; v != nil && v.InstanceType != nil && (ds.ClusterConfig == nil || aws.StringValue(ds.ClusterConfig.InstanceType) != aws.StringValue(v.InstanceType)) {
+		return false
+	}
+
+	return true
+}
+
 func FindDomainByName(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) (*opensearchservice.DomainStatus, error) {
 	input := &opensearchservice.DescribeDomainInput{
 		DomainName: aws.String(name),
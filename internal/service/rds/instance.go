@@ -1784,7 +1784,25 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "creating RDS DB Instance (%s): %s", identifier, err)
+			// An earlier apply may have been interrupted while waiting for the DB instance to be created.
+			var instance *rds.DBInstance
+			instance, err = tfresource.ResumeCreate(ctx, err,
+				func(err error) bool {
+					return tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceAlreadyExistsFault)
+				},
+				func(ctx context.Context) (*rds.DBInstance, error) {
+					return findDBInstanceByIDSDKv1(ctx, conn, identifier)
+				},
+				func(v *rds.DBInstance) bool {
+					return dbInstanceCreateInProgress(v, input)
+				},
+			)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating RDS DB Instance (%s): %s", identifier, err)
+			}
+
+			outputRaw = &rds.CreateDBInstanceOutput{DBInstance: instance}
 		}
 
 		output := outputRaw.(*rds.CreateDBInstanceOutput)
@@ -2800,6 +2818,15 @@ func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id strin
 	}
 }
 
+// dbInstanceCreateInProgress returns whether the DB instance is still being created with the same engine, instance class
+// and master username as the create request.
+func dbInstanceCreateInProgress(v *rds.DBInstance, input *rds.CreateDBInstanceInput) bool {
+	return aws.StringValue(v.DBInstanceStatus) == InstanceStatusCreating &&
+		strings.EqualFold(aws.StringValue(v.Engine), aws.StringValue(input.Engine)) &&
+		aws.StringValue(v.DBInstanceClass) == aws.StringValue(input.DBInstanceClass) &&
+		aws.StringValue(v.MasterUsername) == aws.StringValue(input.MasterUsername)
+}
+
 func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_TagResources.html.
+	tagResourcesMaxARNs = 20
+	tagResourcesMaxTags = 50
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html.
+	getResourcesMaxARNs = 100
+)
+
+// @SDKResource("aws_resourcegroupstaggingapi_tags", name="Tags")
+func resourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagsCreate,
+		ReadWithoutTimeout:   resourceTagsRead,
+		UpdateWithoutTimeout: resourceTagsUpdate,
+		DeleteWithoutTimeout: resourceTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"resource_arns", "tag_filter"},
+			},
+			"tag_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"resource_arns", "tag_filter"},
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arns, err := tagsResourceARNs(ctx, conn, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Tags: %s", err)
+	}
+
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	if err := tagResources(ctx, conn, arns, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Tags: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	var mappings []awstypes.ResourceTagMapping
+	var arns []string
+	var err error
+
+	if v, ok := d.GetOk("tag_filter"); ok {
+		mappings, err = findResourceTagMappings(ctx, conn, &resourcegroupstaggingapi.GetResourcesInput{
+			TagFilters: expandTagFilters(v.([]interface{})),
+		})
+
+		arns = tfslices.ApplyToAll(mappings, func(v awstypes.ResourceTagMapping) string {
+			return aws.ToString(v.ResourceARN)
+		})
+	} else {
+		arns = flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+		mappings, err = findResourceTagMappingsByARNs(ctx, conn, arns)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	resourceTags := make(map[string]tftags.KeyValueTags, len(mappings))
+	for _, v := range mappings {
+		resourceTags[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+	}
+
+	// Resources that are not found are removed from resource_arns so that the tags on the remaining resources are kept.
+	arns = tfslices.Filter(arns, func(v string) bool {
+		_, ok := resourceTags[v]
+		return ok
+	})
+
+	// Only tags with the same value on all resources are in state so that drift on any resource causes a diff.
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	for _, k := range tags.Keys() {
+		for _, arn := range arns {
+			if v := resourceTags[arn].KeyValue(k); v == nil || *v != *tags.KeyValue(k) {
+				delete(tags, k)
+				break
+			}
+		}
+	}
+
+	d.Set("resource_arns", arns)
+	d.Set(names.AttrTags, tags.Map())
+
+	return diags
+}
+
+func resourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	o, n := d.GetChange(names.AttrTags)
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+
+	o, _ = d.GetChange("resource_arns")
+	oldARNs := flex.ExpandStringValueSet(o.(*schema.Set))
+
+	newARNs, err := tagsResourceARNs(ctx, conn, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	// Remove all managed tags from resources that are no longer targeted.
+	removedARNs := tfslices.Filter(oldARNs, func(v string) bool {
+		return !slices.Contains(newARNs, v)
+	})
+
+	if err := untagResources(ctx, conn, removedARNs, oldTags.Keys()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	if err := untagResources(ctx, conn, newARNs, oldTags.Removed(newTags).Keys()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	if err := tagResources(ctx, conn, newARNs, newTags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tagging API Tags: %s", d.Id())
+	if err := untagResources(ctx, conn, arns, tags.Keys()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// tagsResourceARNs returns the ARNs of the resources targeted by the configuration.
+func tagsResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, d *schema.ResourceData) ([]string, error) {
+	if v, ok := d.GetOk("tag_filter"); ok {
+		mappings, err := findResourceTagMappings(ctx, conn, &resourcegroupstaggingapi.GetResourcesInput{
+			TagFilters: expandTagFilters(v.([]interface{})),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return tfslices.ApplyToAll(mappings, func(v awstypes.ResourceTagMapping) string {
+			return aws.ToString(v.ResourceARN)
+		}), nil
+	}
+
+	return flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set)), nil
+}
+
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, tags tftags.KeyValueTags) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	for _, arns := range tfslices.Chunks(arns, tagResourcesMaxARNs) {
+		for _, tags := range tags.Chunks(tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: arns,
+				Tags:            tags.Map(),
+			}
+
+			output, err := conn.TagResources(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("tagging resources: %w", err)
+			}
+
+			if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+				return fmt.Errorf("tagging resources: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	for _, arns := range tfslices.Chunks(arns, tagResourcesMaxARNs) {
+		for _, keys := range tfslices.Chunks(keys, tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: arns,
+				TagKeys:         keys,
+			}
+
+			output, err := conn.UntagResources(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("untagging resources: %w", err)
+			}
+
+			if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+				return fmt.Errorf("untagging resources: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func failedResourcesError(apiObject map[string]awstypes.FailureInfo) error {
+	var errs []error
+
+	for arn, v := range apiObject {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", arn, v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findResourceTagMappingsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	for _, arns := range tfslices.Chunks(arns, getResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: arns,
+		}
+
+		page, err := findResourceTagMappings(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page...)
+	}
+
+	return output, nil
+}
+
+func findResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPITags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_resourceARNs(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.0", "key1", "value1"),
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.1", "key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccTagsConfig_resourceARNs(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagNotExists(ctx, "aws_sqs_queue.test.0", "key1"),
+					testAccCheckTagNotExists(ctx, "aws_sqs_queue.test.1", "key1"),
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.0", "key2", "value2"),
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.1", "key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccTagsConfig_queues(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagNotExists(ctx, "aws_sqs_queue.test.0", "key2"),
+					testAccCheckTagNotExists(ctx, "aws_sqs_queue.test.1", "key2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITags_tagFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_tagFilter(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.0", "key1", "value1"),
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.1", "key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tag_filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccTagsConfig_tagFilter(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.0", "key1", "value1updated"),
+					testAccCheckTagExists(ctx, "aws_sqs_queue.test.1", "key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagExists(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		output, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, []string{rs.Primary.Attributes[names.AttrARN]})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if got := tfresourcegroupstaggingapi.KeyValueTags(ctx, v.Tags).KeyValue(key); got != nil && *got == value {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s tag %q with value %q not found", n, key, value)
+	}
+}
+
+func testAccCheckTagNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		output, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, []string{rs.Primary.Attributes[names.AttrARN]})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if tfresourcegroupstaggingapi.KeyValueTags(ctx, v.Tags).KeyExists(key) {
+				return fmt.Errorf("%s tag %q still exists", n, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTagsConfig_queues(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+`, rName)
+}
+
+func testAccTagsConfig_resourceARNs(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTagsConfig_queues(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_arns = aws_sqs_queue.test[*].arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTagsConfig_tagFilter(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTagsConfig_queues(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  tag_filter {
+    key    = "Name"
+    values = [%[1]q]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_sqs_queue.test]
+}
+`, rName, tagKey1, tagValue1))
+}
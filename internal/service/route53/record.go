@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_route53_record", name="Record")
+// @Identity("zone_id", "name", "type", "set_identifier", global=true, separator="_")
 func resourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -82,6 +82,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRecord,
 			TypeName: "aws_route53_record",
 			Name:     "Record",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"zone_id", "name", "type", "set_identifier"},
+				Global:     true,
+				Separator:  "_",
+			},
 		},
 		{
 			Factory:  resourceTrafficPolicy,
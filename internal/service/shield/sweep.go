@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_shield_drt_access_log_bucket_association", &resource.Sweeper{
 		Name: "aws_shield_drt_access_log_bucket_association",
 		F:    sweepDRTAccessLogBucketAssociations,
 	})
 
-	resource.AddTestSweepers("aws_shield_drt_access_role_arn_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_shield_drt_access_role_arn_association", &resource.Sweeper{
 		Name: "aws_shield_drt_access_role_arn_association",
 		F:    sweepDRTAccessRoleARNAssociations,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_shield_proactive_engagement", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_shield_proactive_engagement", &resource.Sweeper{
 		Name: "aws_shield_proactive_engagement",
 		F:    sweepProactiveEngagements,
 		Dependencies: []string{
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
 		Name: "aws_signer_signing_profile",
 		F:    sweepSigningProfiles,
 	})
@@ -48,6 +48,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"sns:CreateTopic"},
+				Read:   []string{"sns:GetTopicAttributes"},
+				Update: []string{"sns:SetTopicAttributes"},
+				Delete: []string{"sns:DeleteTopic"},
+			},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
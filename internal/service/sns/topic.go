@@ -240,6 +240,10 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="id")
+// @Permissions("create", "sns:CreateTopic")
+// @Permissions("read", "sns:GetTopicAttributes")
+// @Permissions("update", "sns:SetTopicAttributes")
+// @Permissions("delete", "sns:DeleteTopic")
 // @Testing(existsType="map[string]string")
 func resourceTopic() *schema.Resource {
 	return &schema.Resource{
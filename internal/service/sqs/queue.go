@@ -190,6 +190,10 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @Permissions("create", "sqs:CreateQueue")
+// @Permissions("read", "sqs:GetQueueAttributes")
+// @Permissions("update", "sqs:SetQueueAttributes")
+// @Permissions("delete", "sqs:DeleteQueue")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
 func resourceQueue() *schema.Resource {
 	return &schema.Resource{
@@ -47,6 +47,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"sqs:CreateQueue"},
+				Read:   []string{"sqs:GetQueueAttributes"},
+				Update: []string{"sqs:SetQueueAttributes"},
+				Delete: []string{"sqs:DeleteQueue"},
+			},
 		},
 		{
 			Factory:  resourceQueuePolicy,
@@ -21,15 +21,15 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
 		Name: "aws_ssoadmin_account_assignment",
 		F:    sweepAccountAssignments,
 	})
-	resource.AddTestSweepers("aws_ssoadmin_application", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ssoadmin_application", &resource.Sweeper{
 		Name: "aws_ssoadmin_application",
 		F:    sweepApplications,
 	})
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
 		Name: "aws_ssoadmin_permission_set",
 		F:    sweepPermissionSets,
 		Dependencies: []string{
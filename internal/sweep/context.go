@@ -9,6 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	sweeperKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -16,5 +23,21 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionKey, region)
+
 	return ctx
 }
+
+func withSweeper(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, sweeperKey, name)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey).(string)
+	return v
+}
+
+func sweeperFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(sweeperKey).(string)
+	return v
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourceFilter selects which of the resources listed by sweepers are deleted.
+// Resources are read before they are deleted in order to apply a non-empty filter.
+type ResourceFilter struct {
+	// Tags are the tags that a resource must have. An empty value matches any value for the key.
+	Tags map[string]string
+	// MinAge is the minimum time since a resource was created.
+	// Resources whose creation time is not known are not deleted.
+	MinAge time.Duration
+}
+
+func (f ResourceFilter) IsEmpty() bool {
+	return len(f.Tags) == 0 && f.MinAge == 0
+}
+
+// Inspector is implemented by Sweepables that can read the resource they delete.
+type Inspector interface {
+	// Inspect reads the resource and returns the values of its top-level string and map attributes.
+	// A nil map is returned if the resource no longer exists.
+	Inspect(ctx context.Context) (map[string]any, error)
+}
+
+// creationTimeAttributes are the names of the attributes that hold a resource's creation time.
+var creationTimeAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreateTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// match returns whether a resource with the specified tags and creation time matches the filter.
+// If it doesn't, the reason is returned.
+func (f ResourceFilter) match(tags map[string]string, createdAt, now time.Time) (bool, string) {
+	keys := maps.Keys(f.Tags)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		v, ok := tags[k]
+
+		if !ok {
+			return false, fmt.Sprintf("tag %q not found", k)
+		}
+
+		if want := f.Tags[k]; want != "" && v != want {
+			return false, fmt.Sprintf("tag %q has value %q", k, v)
+		}
+	}
+
+	if f.MinAge > 0 {
+		if createdAt.IsZero() {
+			return false, "creation time not known"
+		}
+
+		if age := now.Sub(createdAt); age < f.MinAge {
+			return false, fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return true, ""
+}
+
+// inspect reads a resource and returns its tags and its creation time, if known.
+// Tags that are not set by the resource's Read handler are looked up by ARN using the Resource Groups Tagging API.
+func inspect(ctx context.Context, inspector Inspector) (map[string]string, time.Time, bool, error) {
+	attributes, err := inspector.Inspect(ctx)
+
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+
+	if attributes == nil {
+		return nil, time.Time{}, false, nil
+	}
+
+	tags := stringMapAttribute(attributes, names.AttrTagsAll)
+	if len(tags) == 0 {
+		tags = stringMapAttribute(attributes, names.AttrTags)
+	}
+
+	if v, ok := attributes[names.AttrARN].(string); ok && v != "" && len(tags) == 0 {
+		tags, err = resourceTags(ctx, v)
+
+		if err != nil {
+			return nil, time.Time{}, false, err
+		}
+	}
+
+	return tags, creationTime(attributes), true, nil
+}
+
+func stringMapAttribute(attributes map[string]any, name string) map[string]string {
+	m, ok := attributes[name].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		if v, ok := v.(string); ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// creationTime returns the value of the first creation time attribute in RFC 3339 format.
+func creationTime(attributes map[string]any) time.Time {
+	for _, name := range creationTimeAttributes {
+		if v, ok := attributes[name].(string); ok {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return t
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+func resourceTags(ctx context.Context, arn string) (map[string]string, error) {
+	client, err := SharedRegionalSweepClient(ctx, regionFromContext(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: []string{arn},
+	}
+
+	output, err := client.ResourceGroupsTaggingAPIClient(ctx).GetResources(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading tags (%s): %w", arn, err)
+	}
+
+	tags := make(map[string]string)
+	for _, v := range output.ResourceTagMappingList {
+		for _, v := range v.Tags {
+			tags[aws.ToString(v.Key)] = aws.ToString(v.Value)
+		}
+	}
+
+	return tags, nil
+}
+
+// ParseTags parses a comma-separated list of key=value tag filters.
+// A key without a value matches any value.
+func ParseTags(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		k, v, _ := strings.Cut(v, "=")
+		if k = strings.TrimSpace(k); k == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): empty key", s)
+		}
+
+		tags[k] = strings.TrimSpace(v)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResourceFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter         ResourceFilter
+		tags           map[string]string
+		createdAt      time.Time
+		expected       bool
+		expectedReason string
+	}{
+		"empty": {
+			expected: true,
+		},
+		"tag value": {
+			filter: ResourceFilter{
+				Tags: map[string]string{"Environment": "sandbox"},
+			},
+			tags:     map[string]string{"Environment": "sandbox", "Name": "test"},
+			expected: true,
+		},
+		"tag any value": {
+			filter: ResourceFilter{
+				Tags: map[string]string{"Owner": ""},
+			},
+			tags:     map[string]string{"Owner": "someone"},
+			expected: true,
+		},
+		"tag not found": {
+			filter: ResourceFilter{
+				Tags: map[string]string{"Environment": "sandbox", "Owner": ""},
+			},
+			tags:           map[string]string{"Environment": "sandbox"},
+			expectedReason: `tag "Owner" not found`,
+		},
+		"tag different value": {
+			filter: ResourceFilter{
+				Tags: map[string]string{"Environment": "sandbox"},
+			},
+			tags:           map[string]string{"Environment": "production"},
+			expectedReason: `tag "Environment" has value "production"`,
+		},
+		"old enough": {
+			filter: ResourceFilter{
+				MinAge: 24 * time.Hour,
+			},
+			createdAt: now.Add(-48 * time.Hour),
+			expected:  true,
+		},
+		"too new": {
+			filter: ResourceFilter{
+				MinAge: 24 * time.Hour,
+			},
+			createdAt:      now.Add(-90 * time.Minute),
+			expectedReason: "created 1h30m0s ago",
+		},
+		"creation time not known": {
+			filter: ResourceFilter{
+				MinAge: 24 * time.Hour,
+			},
+			expectedReason: "creation time not known",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.match(testCase.tags, testCase.createdAt, now)
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+
+			if reason != testCase.expectedReason {
+				t.Errorf("got reason %q, expected %q", reason, testCase.expectedReason)
+			}
+		})
+	}
+}
+
+func TestCreationTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]any
+		expected   time.Time
+	}{
+		"none": {
+			attributes: map[string]any{
+				"name": "test",
+			},
+		},
+		"created_at": {
+			attributes: map[string]any{
+				"created_at": "2024-05-01T10:00:00Z",
+			},
+			expected: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		"invalid": {
+			attributes: map[string]any{
+				"creation_date": "yesterday",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := creationTime(testCase.attributes); !got.Equal(testCase.expected) {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      map[string]string
+		expectedError bool
+	}{
+		"empty": {
+			expected: map[string]string{},
+		},
+		"values": {
+			input: "Environment=sandbox, Owner",
+			expected: map[string]string{
+				"Environment": "sandbox",
+				"Owner":       "",
+			},
+		},
+		"empty key": {
+			input:         "=sandbox",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTags(testCase.input)
+
+			if got, expected := err != nil, testCase.expectedError; got != expected {
+				t.Fatalf("expected error: %t, got: %s", expected, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,32 +50,60 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+// String returns the resource's ID, or the values of its identifying attributes if it has no ID attribute.
+func (sr *sweepResource) String() string {
+	values := make([]string, 0, len(sr.attributes))
+
+	for _, attr := range sr.attributes {
+		if attr.path == names.AttrID {
+			return fmt.Sprint(attr.value)
+		}
+		values = append(values, fmt.Sprint(attr.value))
+	}
+
+	return strings.Join(values, ",")
+}
+
+// Inspect reads the resource and returns the values of its top-level string and map attributes.
+func (sr *sweepResource) Inspect(ctx context.Context) (map[string]any, error) {
+	ctx, resource, state, err := sr.state(ctx)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
 
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
 
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
 
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
+	var values map[string]tftypes.Value
+	if err := response.State.Raw.As(&values); err != nil {
+		return nil, err
 	}
 
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+	attributes := make(map[string]any)
+
+	for k, v := range values {
+		if v, ok := attributeValue(v); ok {
+			attributes[k] = v
 		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return attributes, nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	ctx, resource, state, err := sr.state(ctx)
+
+	if err != nil {
+		return err
 	}
 
 	tflog.Info(ctx, "Sweeping resource")
@@ -118,6 +148,71 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// state returns the configured resource and its state with the identifying attributes set.
+func (sr *sweepResource) state(ctx context.Context) (context.Context, fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return ctx, nil, tfsdk.State{}, err
+	}
+
+	metadata := resourceMetadata(ctx, resource)
+	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return ctx, resource, state, nil
+}
+
+// attributeValue returns the value of a known, non-null string or map of string attribute.
+func attributeValue(v tftypes.Value) (any, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return nil, false
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, false
+		}
+
+		return s, true
+	case v.Type().Is(tftypes.Map{ElementType: tftypes.String}):
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return nil, false
+		}
+
+		m := make(map[string]any, len(elements))
+		for k, v := range elements {
+			if v, ok := attributeValue(v); ok {
+				m[k] = v
+			}
+		}
+
+		return m, true
+	default:
+		return nil, false
+	}
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type Outcome string
+
+const (
+	// OutcomeCompleted is the outcome of a sweeper that ran without error.
+	OutcomeCompleted Outcome = "completed"
+	// OutcomeDeleted is the outcome of a resource that was deleted.
+	OutcomeDeleted Outcome = "deleted"
+	// OutcomeFailed is the outcome of a sweeper or resource that returned an error.
+	OutcomeFailed Outcome = "failed"
+	// OutcomeListed is the outcome of a resource that would have been deleted in a list-only run.
+	OutcomeListed Outcome = "listed"
+	// OutcomeSkipped is the outcome of a sweeper that was skipped, for example because the service is not
+	// available in the Region, or of a resource that did not match the resource filter.
+	OutcomeSkipped Outcome = "skipped"
+)
+
+// SweeperResult is the outcome of running a sweeper in a Region.
+type SweeperResult struct {
+	Name    string  `json:"name"`
+	Region  string  `json:"region"`
+	Outcome Outcome `json:"outcome"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// ResourceResult is the outcome of sweeping a resource.
+// The sweeper is not known for resources swept by a function registered for more than one sweeper.
+type ResourceResult struct {
+	Sweeper string  `json:"sweeper,omitempty"`
+	Region  string  `json:"region"`
+	ID      string  `json:"id"`
+	Outcome Outcome `json:"outcome"`
+	Reason  string  `json:"reason,omitempty"`
+}
+
+// Report records the outcome of each sweeper and each resource in a sweeper run.
+type Report struct {
+	mu        sync.Mutex
+	sweepers  map[string]*SweeperResult
+	resources []ResourceResult
+}
+
+func NewReport() *Report {
+	return &Report{
+		sweepers: make(map[string]*SweeperResult),
+	}
+}
+
+func (r *Report) addSweeper(region, name string, outcome Outcome, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := region + "/" + name
+
+	// A sweeper that reported itself as skipped remains skipped.
+	if v, ok := r.sweepers[key]; ok && v.Outcome == OutcomeSkipped {
+		return
+	}
+
+	result := &SweeperResult{
+		Name:    name,
+		Region:  region,
+		Outcome: outcome,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	r.sweepers[key] = result
+}
+
+func (r *Report) addResource(result ResourceResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.resources = append(r.resources, result)
+}
+
+// Sweepers returns the sweeper results ordered by Region and name.
+func (r *Report) Sweepers() []SweeperResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := make([]SweeperResult, 0, len(r.sweepers))
+	for _, v := range r.sweepers {
+		results = append(results, *v)
+	}
+
+	slices.SortFunc(results, func(a, b SweeperResult) int {
+		return cmp.Or(cmp.Compare(a.Region, b.Region), cmp.Compare(a.Name, b.Name))
+	})
+
+	return results
+}
+
+// Resources returns the resource results ordered by Region, sweeper and ID.
+func (r *Report) Resources() []ResourceResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := slices.Clone(r.resources)
+
+	slices.SortFunc(results, func(a, b ResourceResult) int {
+		return cmp.Or(cmp.Compare(a.Region, b.Region), cmp.Compare(a.Sweeper, b.Sweeper), cmp.Compare(a.ID, b.ID))
+	})
+
+	return results
+}
+
+// Counts returns the number of resources with each outcome.
+func (r *Report) Counts() map[Outcome]int {
+	counts := make(map[Outcome]int)
+
+	for _, v := range r.Resources() {
+		counts[v.Outcome]++
+	}
+
+	return counts
+}
+
+func (r *Report) WriteJSON(w io.Writer) error {
+	v := struct {
+		Sweepers  []SweeperResult  `json:"sweepers"`
+		Resources []ResourceResult `json:"resources"`
+	}{
+		Sweepers:  r.Sweepers(),
+		Resources: r.Resources(),
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var sb strings.Builder
+
+	counts := r.Counts()
+
+	sb.WriteString("# Sweeper Report\n\n")
+	fmt.Fprintf(&sb, "| Deleted | Listed | Skipped | Failed |\n")
+	fmt.Fprintf(&sb, "|---------|--------|---------|--------|\n")
+	fmt.Fprintf(&sb, "| %d | %d | %d | %d |\n", counts[OutcomeDeleted], counts[OutcomeListed], counts[OutcomeSkipped], counts[OutcomeFailed])
+
+	sb.WriteString("\n## Sweepers\n\n")
+	sb.WriteString("| Region | Sweeper | Outcome | Error |\n")
+	sb.WriteString("|--------|---------|---------|-------|\n")
+	for _, v := range r.Sweepers() {
+		fmt.Fprintf(&sb, "| %s | `%s` | %s | %s |\n", v.Region, v.Name, v.Outcome, markdownEscape(v.Error))
+	}
+
+	sb.WriteString("\n## Resources\n\n")
+	sb.WriteString("| Region | Sweeper | ID | Outcome | Reason |\n")
+	sb.WriteString("|--------|---------|----|---------|--------|\n")
+	for _, v := range r.Resources() {
+		sweeper := ""
+		if v.Sweeper != "" {
+			sweeper = "`" + v.Sweeper + "`"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | `%s` | %s | %s |\n", v.Region, sweeper, v.ID, v.Outcome, markdownEscape(v.Reason))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
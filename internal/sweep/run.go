@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+const defaultParallelism = 10
+
+// sweepers is the registry of all sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// orchestrated is the set of sweepers that delete resources only through SweepOrchestrator.
+var orchestrated = make(map[string]struct{})
+
+// sweeperFuncs maps the entry point of each sweeper's function to the sweeper's name.
+// Sweeper functions create their own contexts, so SweepOrchestrator uses it to find the sweeper that called it.
+var sweeperFuncs = make(map[uintptr]string)
+
+// AddTestSweepers registers a sweeper so that it can be run, in dependency order, by Run.
+// Sweeper names must be unique.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already registered", name)
+	}
+
+	sweepers[name] = s
+
+	if s.F != nil {
+		pc := reflect.ValueOf(s.F).Pointer()
+
+		// A function registered for more than one sweeper can't be attributed to either.
+		if _, ok := sweeperFuncs[pc]; ok {
+			sweeperFuncs[pc] = ""
+		} else {
+			sweeperFuncs[pc] = name
+		}
+	}
+}
+
+// AddOrchestratedTestSweepers registers a sweeper whose function deletes resources only through SweepOrchestrator,
+// and so honors RunOptions.ListOnly and RunOptions.ResourceFilter.
+// Sweepers registered with AddTestSweepers are not run when either is set.
+func AddOrchestratedTestSweepers(name string, s *resource.Sweeper) {
+	AddTestSweepers(name, s)
+
+	orchestrated[name] = struct{}{}
+}
+
+// callingSweeper returns the name of the sweeper whose function, as registered in funcs, is on the call stack.
+func callingSweeper(funcs map[uintptr]string) string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		if name, ok := funcs[frame.Entry]; ok {
+			return name
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// restricted returns whether a run with the specified options must not delete resources other than through SweepOrchestrator.
+func (opts RunOptions) restricted() bool {
+	return opts.ListOnly || !opts.ResourceFilter.IsEmpty()
+}
+
+// errNotOrchestrated is the reason a sweeper that deletes resources directly is not run in a restricted run.
+var errNotOrchestrated = errors.New("sweeper deletes resources directly, ignoring list-only runs and resource filters")
+
+// RunOptions configures a sweeper run.
+type RunOptions struct {
+	// AllowFailures continues with the remaining sweepers after a failure.
+	AllowFailures bool
+	// DryRun prints the computed plan without running any sweepers.
+	DryRun bool
+	// Filter is a comma-separated list of sweeper names (or name substrings) to run.
+	// The dependencies of any matching sweeper are always included.
+	Filter string
+	// ListOnly runs the sweepers but only lists the resources that would be deleted.
+	// Sweepers not registered with AddOrchestratedTestSweepers or Register are not run.
+	ListOnly bool
+	// Parallelism is the maximum number of sweepers run concurrently within a level.
+	Parallelism int
+	// Report, if set, records the outcome of each sweeper and resource.
+	Report *Report
+	// ResourceFilter selects which of the resources listed by sweepers are deleted.
+	// If set, sweepers not registered with AddOrchestratedTestSweepers or Register are not run.
+	ResourceFilter ResourceFilter
+	// Services is a list of service package names whose sweepers are run in addition to those matching Filter.
+	// The dependencies of any matching sweeper are always included.
+	Services []string
+}
+
+var (
+	// runOptions are the options of the run in progress, used by SweepOrchestrator.
+	runOptions     RunOptions
+	runOptionsLock sync.RWMutex
+)
+
+func currentRunOptions() RunOptions {
+	runOptionsLock.RLock()
+	defer runOptionsLock.RUnlock()
+
+	return runOptions
+}
+
+func setRunOptions(opts RunOptions) {
+	runOptionsLock.Lock()
+	defer runOptionsLock.Unlock()
+
+	runOptions = opts
+}
+
+// Run runs the registered sweepers in each of the specified Regions.
+// Sweepers are grouped into levels using the dependency graph built from their registered
+// dependencies and each level is run in parallel once all the levels before it have completed.
+func Run(regions []string, opts RunOptions) error {
+	var serviceOf map[string]string
+	if len(opts.Services) > 0 {
+		serviceOf = resourceTypeServices(context.Background())
+	}
+
+	levels, err := plan(sweepers, matcher(opts.Filter, opts.Services, serviceOf))
+	if err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		log.Printf("Sweeper plan for regions (%s):\n%s", strings.Join(regions, ","), formatPlan(levels))
+
+		return nil
+	}
+
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = defaultParallelism
+	}
+
+	setRunOptions(opts)
+	defer setRunOptions(RunOptions{})
+
+	var sweeperErrorFound bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		results, err := runRegion(region, sweepers, orchestrated, levels, opts)
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		logResults(region, results)
+
+		if err != nil {
+			return err
+		}
+
+		for _, runE := range results {
+			if runE != nil {
+				sweeperErrorFound = true
+			}
+		}
+	}
+
+	if sweeperErrorFound {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// plan returns the selected sweepers (plus their dependencies) grouped into levels.
+// Every sweeper in a level depends only on sweepers in earlier levels.
+func plan(sweepers map[string]*resource.Sweeper, match func(string) bool) ([][]string, error) {
+	g, err := graph(sweepers)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := filterSweepers(g, match)
+	if err != nil {
+		return nil, err
+	}
+
+	var levels [][]string
+	level := make(map[string]int)
+
+	// OverallOrder returns dependencies before their dependents.
+	for _, name := range order {
+		if _, ok := selected[name]; !ok {
+			continue
+		}
+
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		n := 0
+		for _, dependency := range dependencies {
+			n = max(n, level[dependency]+1)
+		}
+		level[name] = n
+
+		if n == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[n] = append(levels[n], name)
+	}
+
+	for _, v := range levels {
+		slices.Sort(v)
+	}
+
+	return levels, nil
+}
+
+// graph builds the dependency graph for the specified sweepers.
+// An edge from A to B means that B must be swept before A.
+func graph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// matcher returns a function that reports whether a sweeper is selected, either by matching the comma-separated
+// name filter or by belonging to one of the specified service packages.
+// With no filter and no service packages all sweepers are selected.
+// serviceOf maps resource type names to service package names.
+func matcher(filter string, services []string, serviceOf map[string]string) func(string) bool {
+	var filters []string
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			filters = append(filters, v)
+		}
+	}
+
+	if len(filters) == 0 && len(services) == 0 {
+		return func(string) bool {
+			return true
+		}
+	}
+
+	return func(name string) bool {
+		if slices.ContainsFunc(filters, func(v string) bool {
+			return strings.Contains(strings.ToLower(name), v)
+		}) {
+			return true
+		}
+
+		if v, ok := serviceOf[name]; ok && slices.Contains(services, v) {
+			return true
+		}
+
+		return false
+	}
+}
+
+// resourceTypeServices returns the service package name of each resource type.
+func resourceTypeServices(ctx context.Context) map[string]string {
+	serviceOf := make(map[string]string)
+
+	for _, sp := range ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.SDKResources(ctx) {
+			serviceOf[v.TypeName] = servicePackageName
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var response fwresource.MetadataResponse
+			r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+
+			serviceOf[response.TypeName] = servicePackageName
+		}
+	}
+
+	return serviceOf
+}
+
+// filterSweepers returns the names of the selected sweepers and all their dependencies.
+func filterSweepers(g *depgraph.Graph, match func(string) bool) (map[string]struct{}, error) {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]struct{})
+
+	for _, name := range order {
+		if !match(name) {
+			continue
+		}
+
+		selected[name] = struct{}{}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	return selected, nil
+}
+
+// runRegion runs the sweepers in each level in parallel, waiting for a level to complete before starting the next.
+// Without AllowFailures no further levels are started after a sweeper fails.
+// In a restricted run, sweepers that are not orchestrated are skipped.
+func runRegion(region string, sweepers map[string]*resource.Sweeper, orchestrated map[string]struct{}, levels [][]string, opts RunOptions) (map[string]error, error) {
+	var mu sync.Mutex
+	results := make(map[string]error)
+
+	for i, names := range levels {
+		log.Printf("[DEBUG] Running Sweeper level %d of %d in region (%s): %s", i+1, len(levels), region, strings.Join(names, ", "))
+
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, opts.Parallelism)
+
+		for _, name := range names {
+			s := sweepers[name]
+
+			wg.Add(1)
+			semaphore <- struct{}{}
+
+			go func() {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+
+				if _, ok := orchestrated[name]; !ok && opts.restricted() {
+					log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, errNotOrchestrated)
+
+					if opts.Report != nil {
+						opts.Report.addSweeper(region, name, OutcomeSkipped, errNotOrchestrated)
+					}
+
+					mu.Lock()
+					results[name] = nil
+					mu.Unlock()
+
+					return
+				}
+
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+				start := time.Now()
+				runE := s.F(region)
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+				if runE != nil {
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, runE)
+				}
+
+				if opts.Report != nil {
+					switch {
+					case runE == nil:
+						opts.Report.addSweeper(region, name, OutcomeCompleted, nil)
+					case awsv2.SkipSweepError(runE):
+						opts.Report.addSweeper(region, name, OutcomeSkipped, runE)
+					default:
+						opts.Report.addSweeper(region, name, OutcomeFailed, runE)
+					}
+				}
+
+				mu.Lock()
+				results[name] = runE
+				mu.Unlock()
+			}()
+		}
+
+		wg.Wait()
+
+		if opts.AllowFailures {
+			continue
+		}
+
+		for _, name := range names {
+			if err := results[name]; err != nil {
+				return results, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func logResults(region string, results map[string]error) {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+	for _, name := range names {
+		if results[name] == nil {
+			log.Printf("\t- %s\n", name)
+		}
+	}
+
+	if slices.ContainsFunc(names, func(name string) bool { return results[name] != nil }) {
+		log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+		for _, name := range names {
+			if err := results[name]; err != nil {
+				log.Printf("\t- %s: %s\n", name, err)
+			}
+		}
+	}
+}
+
+func formatPlan(levels [][]string) string {
+	var sb strings.Builder
+
+	for i, names := range levels {
+		fmt.Fprintf(&sb, "Level %d:\n", i+1)
+		for _, name := range names {
+			fmt.Fprintf(&sb, "\t- %s\n", name)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers      map[string][]string
+		filter        string
+		services      []string
+		serviceOf     map[string]string
+		expected      [][]string
+		expectedError bool
+	}{
+		"empty": {
+			sweepers: map[string][]string{},
+		},
+		"no dependencies": {
+			sweepers: map[string][]string{
+				"aws_b": nil,
+				"aws_a": nil,
+			},
+			expected: [][]string{{"aws_a", "aws_b"}},
+		},
+		"levels": {
+			sweepers: map[string][]string{
+				"aws_vpc":            {"aws_subnet", "aws_security_group"},
+				"aws_subnet":         {"aws_network_interface"},
+				"aws_security_group": {"aws_network_interface"},
+				"aws_network_interface": {
+					"aws_instance",
+				},
+				"aws_instance":  nil,
+				"aws_s3_bucket": nil,
+			},
+			expected: [][]string{
+				{"aws_instance", "aws_s3_bucket"},
+				{"aws_network_interface"},
+				{"aws_security_group", "aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"filter includes dependencies": {
+			sweepers: map[string][]string{
+				"aws_vpc":       {"aws_subnet"},
+				"aws_subnet":    {"aws_instance"},
+				"aws_instance":  nil,
+				"aws_s3_bucket": nil,
+			},
+			filter: "AWS_SUBNET",
+			expected: [][]string{
+				{"aws_instance"},
+				{"aws_subnet"},
+			},
+		},
+		"services": {
+			sweepers: map[string][]string{
+				"aws_vpc":       {"aws_subnet"},
+				"aws_subnet":    nil,
+				"aws_instance":  nil,
+				"aws_s3_bucket": nil,
+				"aws_sqs_queue": nil,
+			},
+			filter:   "aws_sqs",
+			services: []string{"ec2"},
+			serviceOf: map[string]string{
+				"aws_instance":  "ec2",
+				"aws_s3_bucket": "s3",
+				"aws_sqs_queue": "sqs",
+				"aws_subnet":    "ec2",
+				"aws_vpc":       "ec2",
+			},
+			expected: [][]string{
+				{"aws_instance", "aws_sqs_queue", "aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"missing dependency": {
+			sweepers: map[string][]string{
+				"aws_vpc": {"aws_subnet"},
+			},
+			expectedError: true,
+		},
+		"cycle": {
+			sweepers: map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_c"},
+				"aws_c": {"aws_a"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sweepers := make(map[string]*resource.Sweeper)
+			for sweeperName, dependencies := range testCase.sweepers {
+				sweepers[sweeperName] = &resource.Sweeper{
+					Name:         sweeperName,
+					Dependencies: dependencies,
+				}
+			}
+
+			got, err := plan(sweepers, matcher(testCase.filter, testCase.services, testCase.serviceOf))
+
+			if got, expected := err != nil, testCase.expectedError; got != expected {
+				t.Fatalf("expected error: %t, got: %s", expected, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunRegionRestricted(t *testing.T) {
+	t.Parallel()
+
+	orchestrated := map[string]struct{}{
+		"aws_test_orchestrated": {},
+	}
+
+	testCases := map[string]struct {
+		opts     RunOptions
+		expected []string
+	}{
+		"unrestricted": {
+			expected: []string{"aws_test_direct", "aws_test_orchestrated"},
+		},
+		"list only": {
+			opts: RunOptions{
+				ListOnly: true,
+			},
+			expected: []string{"aws_test_orchestrated"},
+		},
+		"resource filter": {
+			opts: RunOptions{
+				ResourceFilter: ResourceFilter{
+					Tags: map[string]string{"Environment": "sandbox"},
+				},
+			},
+			expected: []string{"aws_test_orchestrated"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var got []string
+			sweeper := func(name string) *resource.Sweeper {
+				return &resource.Sweeper{
+					Name: name,
+					F: func(region string) error {
+						mu.Lock()
+						defer mu.Unlock()
+
+						got = append(got, name)
+
+						return nil
+					},
+				}
+			}
+			sweepers := map[string]*resource.Sweeper{
+				"aws_test_direct":       sweeper("aws_test_direct"),
+				"aws_test_orchestrated": sweeper("aws_test_orchestrated"),
+			}
+
+			opts := testCase.opts
+			opts.Parallelism = 1
+			opts.Report = NewReport()
+
+			results, err := runRegion("us-west-2", sweepers, orchestrated, [][]string{{"aws_test_direct", "aws_test_orchestrated"}}, opts) //lintignore:AWSAT003
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := len(results), 2; got != expected {
+				t.Errorf("incorrect number of results. Expected: %d, got: %d", expected, got)
+			}
+
+			slices.Sort(got)
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCallingSweeper(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	funcs := make(map[uintptr]string)
+	s := &resource.Sweeper{
+		Name: "aws_test",
+		F: func(region string) error {
+			got = callingSweeper(funcs)
+
+			return nil
+		},
+	}
+	funcs[reflect.ValueOf(s.F).Pointer()] = s.Name
+
+	if err := s.F("us-west-2"); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "aws_test"; got != expected {
+		t.Errorf("incorrect calling sweeper. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := callingSweeper(funcs), ""; got != expected {
+		t.Errorf("incorrect calling sweeper. Expected: %s, got: %s", expected, got)
+	}
+}
@@ -89,6 +89,35 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// String returns the resource's ID.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// Inspect reads the resource and returns the values of its top-level string and map attributes.
+func (sr *sweepResource) Inspect(ctx context.Context) (map[string]any, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil
+	}
+
+	attributes := make(map[string]any)
+
+	for k, v := range sr.resource.SchemaMap() {
+		switch v.Type {
+		case schema.TypeString, schema.TypeMap:
+			attributes[k] = sr.d.Get(k)
+		}
+	}
+
+	return attributes, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
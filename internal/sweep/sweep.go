@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -35,8 +37,14 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock serializes client creation as sweepers in the same level run in parallel.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -109,19 +117,100 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if sweeperFromContext(ctx) == "" {
+		ctx = withSweeper(ctx, callingSweeper(sweeperFuncs))
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			return sweepResource(ctx, sweepable, optFns...)
 		})
 	}
 
 	return g.Wait().ErrorOrNil()
 }
 
+// sweepResource deletes a resource if it matches the resource filter of the current run,
+// recording the outcome in the run's report.
+func sweepResource(ctx context.Context, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	opts := currentRunOptions()
+
+	result := ResourceResult{
+		Sweeper: sweeperFromContext(ctx),
+		Region:  regionFromContext(ctx),
+	}
+	if v, ok := sweepable.(fmt.Stringer); ok {
+		result.ID = v.String()
+	}
+
+	record := func(outcome Outcome, reason string) {
+		if opts.Report == nil {
+			return
+		}
+
+		result.Outcome = outcome
+		result.Reason = reason
+		opts.Report.addResource(result)
+	}
+
+	if !opts.ResourceFilter.IsEmpty() {
+		inspector, ok := sweepable.(Inspector)
+		if !ok {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"skip_reason": "resource cannot be inspected",
+			})
+			record(OutcomeSkipped, "resource cannot be inspected")
+
+			return nil
+		}
+
+		tags, createdAt, found, err := inspect(ctx, inspector)
+		if err != nil {
+			record(OutcomeFailed, err.Error())
+
+			return err
+		}
+
+		if !found {
+			record(OutcomeSkipped, "resource not found")
+
+			return nil
+		}
+
+		if ok, reason := opts.ResourceFilter.match(tags, createdAt, time.Now()); !ok {
+			tflog.Debug(ctx, "Skipping resource", map[string]any{
+				"skip_reason": reason,
+			})
+			record(OutcomeSkipped, reason)
+
+			return nil
+		}
+	}
+
+	if opts.ListOnly {
+		tflog.Info(ctx, "Listing resource", map[string]any{
+			"id": result.ID,
+		})
+		record(OutcomeListed, "")
+
+		return nil
+	}
+
+	if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+		record(OutcomeFailed, err.Error())
+
+		return err
+	}
+
+	record(OutcomeDeleted, "")
+
+	return nil
+}
+
 // Deprecated: Use awsv1.SkipSweepError
 var SkipSweepError = awsv1.SkipSweepError
 
@@ -136,11 +225,13 @@ func PartitionDNSSuffix(region string) string {
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+	AddOrchestratedTestSweepers(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
+			ctx = withSweeper(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -148,10 +239,13 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 			}
 			sweepResources, err := f(ctx, client)
 
-			if SkipSweepError(err) {
+			if SkipSweepError(err) || awsv2.SkipSweepError(err) {
 				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
 					"error": err.Error(),
 				})
+				if report := currentRunOptions().Report; report != nil {
+					report.addSweeper(region, name, OutcomeSkipped, err)
+				}
 				return nil
 			}
 			if err != nil {
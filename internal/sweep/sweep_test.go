@@ -5,12 +5,20 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "Print the sweeper plan without running any sweepers")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of sweepers run in parallel within a dependency level")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +26,31 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
-	resource.TestMain(m)
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	opts := sweep.RunOptions{
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		DryRun:        *flagSweepDryRun,
+		Filter:        flagValue("sweep-run"),
+		Parallelism:   *flagSweepParallelism,
+	}
+
+	if err := sweep.Run(strings.Split(regions, ","), opts); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// flagValue returns the value of a flag registered by the terraform-plugin-testing helper/resource package.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
 }
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// sweeper runs the resource sweepers registered by each service package's sweep.go outside of `go test`.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	allowFailures  = flag.Bool("allow-failures", false, "Continue running sweepers after a sweeper fails")
+	dryRun         = flag.Bool("dry-run", false, "Print the sweeper plan without running any sweepers")
+	jsonReport     = flag.String("json-report", "", "Write a JSON report of deleted, skipped and failed resources to this file")
+	listOnly       = flag.Bool("list-only", false, "List the resources that would be deleted without deleting them")
+	markdownReport = flag.String("markdown-report", "", "Write a Markdown report of deleted, skipped and failed resources to this file")
+	olderThan      = flag.Duration("older-than", 0, "Only delete resources created at least this long ago, e.g. 24h")
+	parallelism    = flag.Int("parallelism", 10, "Maximum number of sweepers run in parallel within a dependency level")
+	regions        = flag.String("regions", "", "Comma-separated list of Regions to sweep (required)")
+	run            = flag.String("run", "", "Comma-separated list of sweeper names (or name substrings) to run")
+	services       = flag.String("services", "", "Comma-separated list of service packages, e.g. ec2,sqs, whose sweepers are run")
+	tags           = flag.String("tags", "", "Comma-separated list of key=value tags that resources must have to be deleted; a key without a value matches any value")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tsweeper -regions <regions> [-run <sweepers>] [-services <services>] [-tags <tags>] [-older-than <duration>] [-list-only] [-dry-run] [-json-report <file>] [-markdown-report <file>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *regions == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	resourceTags, err := sweep.ParseTags(*tags)
+	if err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+
+	report := sweep.NewReport()
+
+	opts := sweep.RunOptions{
+		AllowFailures: *allowFailures,
+		DryRun:        *dryRun,
+		Filter:        *run,
+		ListOnly:      *listOnly,
+		Parallelism:   *parallelism,
+		Report:        report,
+		ResourceFilter: sweep.ResourceFilter{
+			MinAge: *olderThan,
+			Tags:   resourceTags,
+		},
+		Services: splitList(*services),
+	}
+
+	runErr := sweep.Run(splitList(*regions), opts)
+
+	if err := writeReports(report); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	if runErr != nil {
+		log.Printf("[ERROR] %s", runErr)
+		os.Exit(1)
+	}
+}
+
+func writeReports(report *sweep.Report) error {
+	if *jsonReport != "" {
+		if err := writeReport(*jsonReport, report.WriteJSON); err != nil {
+			return fmt.Errorf("writing JSON report: %w", err)
+		}
+	}
+
+	if *markdownReport != "" {
+		if err := writeReport(*markdownReport, report.WriteMarkdown); err != nil {
+			return fmt.Errorf("writing Markdown report: %w", err)
+		}
+	}
+
+	counts := report.Counts()
+	log.Printf("Resources deleted: %d, listed: %d, skipped: %d, failed: %d",
+		counts[sweep.OutcomeDeleted], counts[sweep.OutcomeListed], counts[sweep.OutcomeSkipped], counts[sweep.OutcomeFailed])
+
+	return nil
+}
+
+func writeReport(filename string, write func(w io.Writer) error) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func splitList(s string) []string {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
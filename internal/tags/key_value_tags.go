@@ -33,6 +33,12 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ServiceTags are defaulted across all resources in a service package, overriding Tags.
+	ServiceTags map[string]KeyValueTags // Keyed by service package name.
+	// ResourceTypeTags are defaulted across all resources of a type, overriding ServiceTags and Tags.
+	ResourceTypeTags map[string]KeyValueTags // Keyed by resource type name.
+	// RequiredTags are the tag keys that all taggable resources must have.
+	RequiredTags []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,6 +62,45 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResource returns the configuration that applies to resources of the
+// specified type in the specified service package, with any service and
+// resource type tags merged on to the provider-wide tags.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	serviceTags, serviceOK := dc.ServiceTags[servicePackageName]
+	resourceTypeTags, resourceTypeOK := dc.ResourceTypeTags[typeName]
+
+	if !serviceOK && !resourceTypeOK {
+		return dc
+	}
+
+	return &DefaultConfig{
+		Tags:         dc.Tags.Merge(serviceTags).Merge(resourceTypeTags),
+		RequiredTags: dc.RequiredTags,
+	}
+}
+
+// MissingRequiredTags returns the configuration's RequiredTags
+// keys that are not present in the given KeyValueTags.
+func (dc *DefaultConfig) MissingRequiredTags(tags KeyValueTags) []string {
+	if dc == nil {
+		return nil
+	}
+
+	var missing []string
+
+	for _, k := range dc.RequiredTags {
+		if !tags.KeyExists(k) {
+			missing = append(missing, k)
+		}
+	}
+
+	return missing
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -317,6 +318,186 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+			"key2": "value2",
+			"key3": "value3",
+		}),
+		ServiceTags: map[string]KeyValueTags{
+			"ec2": New(ctx, map[string]string{
+				"key2": "ec2value2",
+				"key3": "ec2value3",
+			}),
+		},
+		ResourceTypeTags: map[string]KeyValueTags{
+			"aws_instance": New(ctx, map[string]string{
+				"key3": "instancevalue3",
+				"key4": "instancevalue4",
+			}),
+			"aws_s3_bucket": New(ctx, map[string]string{
+				"key1": "bucketvalue1",
+			}),
+		},
+		RequiredTags: []string{"key1"},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "no config",
+			defaultConfig:      nil,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+		},
+		{
+			name:               "no overrides",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name:               "service override",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "ec2value2",
+				"key3": "ec2value3",
+			},
+		},
+		{
+			name:               "service and resource type overrides",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "ec2value2",
+				"key3": "instancevalue3",
+				"key4": "instancevalue4",
+			},
+		},
+		{
+			name:               "resource type override",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want: map[string]string{
+				"key1": "bucketvalue1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.defaultConfig == nil {
+				if got != nil {
+					t.Errorf("got %v; want nil", got)
+				}
+
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+
+			if diff := cmp.Diff(got.RequiredTags, testCase.defaultConfig.RequiredTags); diff != "" {
+				t.Errorf("unexpected RequiredTags diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigMissingRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          []string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+		},
+		{
+			name: "no required tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+		},
+		{
+			name: "all present",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				RequiredTags: []string{"key1", "key2"},
+			},
+		},
+		{
+			name: "some missing",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				RequiredTags: []string{"key1", "key2", "key3"},
+			},
+			want: []string{"key2", "key3"},
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			defaultConfig: &DefaultConfig{
+				RequiredTags: []string{"key1"},
+			},
+			want: []string{"key1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.MissingRequiredTags(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
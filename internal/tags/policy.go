@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Policy represents an AWS Organizations effective tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax.html.
+type Policy struct {
+	Tags map[string]PolicyTag `json:"tags"` // Keyed by lowercase tag key.
+}
+
+// PolicyTag represents the rules for a single tag key in an effective tag policy.
+type PolicyTag struct {
+	Key         string   `json:"tag_key"`
+	Values      []string `json:"tag_value"`
+	EnforcedFor []string `json:"enforced_for"`
+}
+
+// PolicyViolation represents a tag that does not comply with an effective tag policy.
+type PolicyViolation struct {
+	Key      string
+	Message  string
+	Enforced bool // Whether AWS rejects the non-compliant tag for the resource type.
+}
+
+func (v PolicyViolation) String() string {
+	return fmt.Sprintf("tag %q: %s", v.Key, v.Message)
+}
+
+// ParsePolicy parses the content of an effective tag policy.
+func ParsePolicy(content string) (*Policy, error) {
+	var policy Policy
+
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// Validate returns the specified resource tags' violations of the tag policy.
+// The Terraform type name is used to determine whether the policy is enforced for the resource type.
+func (p *Policy) Validate(tags KeyValueTags, typeName string) []PolicyViolation {
+	if p == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		rule, ok := p.Tags[strings.ToLower(k)]
+
+		if !ok {
+			continue
+		}
+
+		enforced := rule.enforcedFor(typeName)
+
+		if rule.Key != "" && k != rule.Key {
+			violations = append(violations, PolicyViolation{
+				Key:      k,
+				Message:  fmt.Sprintf("key must be specified as %q", rule.Key),
+				Enforced: enforced,
+			})
+		}
+
+		if len(rule.Values) > 0 {
+			v := tags.KeyValue(k)
+
+			if v == nil || !slices.ContainsFunc(rule.Values, func(allowed string) bool { return policyValueMatches(allowed, *v) }) {
+				violations = append(violations, PolicyViolation{
+					Key:      k,
+					Message:  fmt.Sprintf("value must be one of %q", rule.Values),
+					Enforced: enforced,
+				})
+			}
+		}
+	}
+
+	return violations
+}
+
+// enforcedFor returns whether the tag rule is enforced for the resource type.
+// Tag policies identify resource types as "<service>:<resource type>", e.g. "ec2:instance", "ec2:*" or "ec2:ALL_SUPPORTED".
+// Rules are never enforced for Terraform resource types that are not mapped to a tag policy resource type.
+func (t PolicyTag) enforcedFor(typeName string) bool {
+	resourceType, ok := policyResourceTypes[typeName]
+
+	if !ok {
+		return false
+	}
+
+	resourceService, resourceType, _ := strings.Cut(resourceType, ":")
+
+	for _, v := range t.EnforcedFor {
+		service, typ, ok := strings.Cut(v, ":")
+
+		if !ok || !strings.EqualFold(service, resourceService) {
+			continue
+		}
+
+		if typ == "*" || typ == "ALL_SUPPORTED" || strings.EqualFold(typ, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyValueMatches returns whether a tag value matches an allowed value.
+// A trailing "*" in the allowed value matches any suffix.
+func policyValueMatches(allowed, value string) bool {
+	if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+
+	return allowed == value
+}
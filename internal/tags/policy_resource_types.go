@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+// policyResourceTypes maps Terraform resource type names to the "<service>:<resource type>" names
+// used by tag policies to enforce compliance.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_supported-resources-enforcement.html.
+var policyResourceTypes = map[string]string{
+	"aws_acm_certificate":         "acm:certificate",
+	"aws_alb":                     "elasticloadbalancing:loadbalancer",
+	"aws_alb_target_group":        "elasticloadbalancing:targetgroup",
+	"aws_ami":                     "ec2:image",
+	"aws_cloudformation_stack":    "cloudformation:stack",
+	"aws_cloudtrail":              "cloudtrail:trail",
+	"aws_cloudwatch_log_group":    "logs:log-group",
+	"aws_cloudwatch_metric_alarm": "cloudwatch:alarm",
+	"aws_customer_gateway":        "ec2:customer-gateway",
+	"aws_db_instance":             "rds:db",
+	"aws_db_option_group":         "rds:og",
+	"aws_db_parameter_group":      "rds:pg",
+	"aws_db_snapshot":             "rds:snapshot",
+	"aws_db_subnet_group":         "rds:subgrp",
+	"aws_dynamodb_table":          "dynamodb:table",
+	"aws_ebs_snapshot":            "ec2:snapshot",
+	"aws_ebs_volume":              "ec2:volume",
+	"aws_ec2_transit_gateway":     "ec2:transit-gateway",
+	"aws_ecr_repository":          "ecr:repository",
+	"aws_ecs_cluster":             "ecs:cluster",
+	"aws_ecs_service":             "ecs:service",
+	"aws_ecs_task_definition":     "ecs:task-definition",
+	"aws_efs_file_system":         "elasticfilesystem:file-system",
+	"aws_eip":                     "ec2:elastic-ip",
+	"aws_eks_cluster":             "eks:cluster",
+	"aws_elasticsearch_domain":    "es:domain",
+	"aws_instance":                "ec2:instance",
+	"aws_internet_gateway":        "ec2:internet-gateway",
+	"aws_key_pair":                "ec2:key-pair",
+	"aws_kinesis_stream":          "kinesis:stream",
+	"aws_kms_key":                 "kms:key",
+	"aws_lambda_function":         "lambda:function",
+	"aws_launch_template":         "ec2:launch-template",
+	"aws_lb":                      "elasticloadbalancing:loadbalancer",
+	"aws_lb_target_group":         "elasticloadbalancing:targetgroup",
+	"aws_nat_gateway":             "ec2:natgateway",
+	"aws_network_acl":             "ec2:network-acl",
+	"aws_network_interface":       "ec2:network-interface",
+	"aws_opensearch_domain":       "es:domain",
+	"aws_rds_cluster":             "rds:cluster",
+	"aws_redshift_cluster":        "redshift:cluster",
+	"aws_route53_zone":            "route53:hostedzone",
+	"aws_route_table":             "ec2:route-table",
+	"aws_s3_bucket":               "s3:bucket",
+	"aws_secretsmanager_secret":   "secretsmanager:secret",
+	"aws_security_group":          "ec2:security-group",
+	"aws_sns_topic":               "sns:topic",
+	"aws_sqs_queue":               "sqs:queue",
+	"aws_ssm_document":            "ssm:document",
+	"aws_ssm_parameter":           "ssm:parameter",
+	"aws_subnet":                  "ec2:subnet",
+	"aws_vpc":                     "ec2:vpc",
+	"aws_vpc_dhcp_options":        "ec2:dhcp-options",
+	"aws_vpc_endpoint":            "ec2:vpc-endpoint",
+	"aws_vpc_peering_connection":  "ec2:vpc-peering-connection",
+	"aws_vpn_connection":          "ec2:vpn-connection",
+	"aws_vpn_gateway":             "ec2:vpn-gateway",
+}
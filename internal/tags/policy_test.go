@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		expected    *Policy
+		expectedErr bool
+	}{
+		"invalid JSON": {
+			content:     "{",
+			expectedErr: true,
+		},
+		"empty": {
+			content:  "{}",
+			expected: &Policy{},
+		},
+		"tags": {
+			content: `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":["100","200*"],"enforced_for":["ec2:instance"]}}}`,
+			expected: &Policy{
+				Tags: map[string]PolicyTag{
+					"costcenter": {
+						Key:         "CostCenter",
+						Values:      []string{"100", "200*"},
+						EnforcedFor: []string{"ec2:instance"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePolicy(testCase.content)
+
+			if got, expected := err != nil, testCase.expectedErr; got != expected {
+				t.Fatalf("incorrect error. Expected: %t, got: %t (%v)", expected, got, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &Policy{
+		Tags: map[string]PolicyTag{
+			"costcenter": {
+				Key:         "CostCenter",
+				Values:      []string{"100", "200*"},
+				EnforcedFor: []string{"ec2:instance", "ec2:volume", "s3:*"},
+			},
+			"owner": {
+				Key: "Owner",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		policy   *Policy
+		tags     KeyValueTags
+		typeName string
+		expected []PolicyViolation
+	}{
+		"nil policy": {
+			tags: New(ctx, map[string]string{"costcenter": "300"}),
+		},
+		"compliant": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"CostCenter": "200-a", "Owner": "me", "Other": "x"}),
+			typeName: "aws_instance",
+		},
+		"key case": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"owner": "me"}),
+			typeName: "aws_instance",
+			expected: []PolicyViolation{
+				{Key: "owner", Message: `key must be specified as "Owner"`},
+			},
+		},
+		"value enforced": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"CostCenter": "300"}),
+			typeName: "aws_instance",
+			expected: []PolicyViolation{
+				{Key: "CostCenter", Message: `value must be one of ["100" "200*"]`, Enforced: true},
+			},
+		},
+		"value enforced for mapped resource type": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"CostCenter": "300"}),
+			typeName: "aws_ebs_volume",
+			expected: []PolicyViolation{
+				{Key: "CostCenter", Message: `value must be one of ["100" "200*"]`, Enforced: true},
+			},
+		},
+		"value enforced for all service types": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"CostCenter": "300"}),
+			typeName: "aws_s3_bucket",
+			expected: []PolicyViolation{
+				{Key: "CostCenter", Message: `value must be one of ["100" "200*"]`, Enforced: true},
+			},
+		},
+		"value not enforced": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"CostCenter": "300"}),
+			typeName: "aws_vpc",
+			expected: []PolicyViolation{
+				{Key: "CostCenter", Message: `value must be one of ["100" "200*"]`},
+			},
+		},
+		"value not enforced for unmapped resource type": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"CostCenter": "300"}),
+			typeName: "aws_s3_example",
+			expected: []PolicyViolation{
+				{Key: "CostCenter", Message: `value must be one of ["100" "200*"]`},
+			},
+		},
+		"multiple": {
+			policy:   policy,
+			tags:     New(ctx, map[string]string{"costcenter": "300", "OWNER": "me"}),
+			typeName: "aws_iam_role",
+			expected: []PolicyViolation{
+				{Key: "OWNER", Message: `key must be specified as "Owner"`},
+				{Key: "costcenter", Message: `key must be specified as "CostCenter"`},
+				{Key: "costcenter", Message: `value must be one of ["100" "200*"]`},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Validate(testCase.tags, testCase.typeName)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
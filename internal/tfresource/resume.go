@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateToken returns a deterministic token identifying the create request `input`,
+// ignoring the specified top-level fields, e.g. `Tags`, whose order may vary between applies.
+// The token can be used as an API's client request (idempotency) token so that repeating the same
+// create request, e.g. after an interrupted apply, does not create a second resource.
+func CreateToken(input any, ignoreFields ...string) (string, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return "", err
+	}
+
+	for _, v := range ignoreFields {
+		delete(m, v)
+	}
+
+	// Map keys are marshaled in sorted order.
+	b, err = json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// ResumeCreate decides whether a create API call that failed because a resource with the same name already exists
+// can be resumed.
+// This happens when an earlier apply was interrupted while waiting for the resource to be created, leaving the
+// resource in AWS but not in state.
+// If `err` satisfies `alreadyExists` and the resource returned by `find` satisfies `inProgress` (it is still being
+// created and matches the create request), the resource is returned with no error and the caller adopts it
+// and resumes waiting for creation to complete.
+// Otherwise the original error is returned.
+func ResumeCreate[T any](ctx context.Context, err error, alreadyExists func(error) bool, find func(context.Context) (T, error), inProgress func(T) bool) (T, error) {
+	var zero T
+
+	if err == nil || !alreadyExists(err) {
+		return zero, err
+	}
+
+	v, findErr := find(ctx)
+
+	if findErr != nil {
+		tflog.Debug(ctx, "finding existing resource to resume create", map[string]any{
+			"error": findErr.Error(),
+		})
+
+		return zero, err
+	}
+
+	if !inProgress(v) {
+		return zero, err
+	}
+
+	tflog.Info(ctx, "resuming interrupted create of existing resource")
+
+	return v, nil
+}
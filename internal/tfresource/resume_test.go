@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestResumeCreate(t *testing.T) {
+	t.Parallel()
+
+	errAlreadyExists := errors.New("AlreadyExists")
+	errOther := errors.New("Other")
+	alreadyExists := func(err error) bool {
+		return errors.Is(err, errAlreadyExists)
+	}
+
+	testCases := map[string]struct {
+		err         error
+		status      string
+		findErr     error
+		expected    string
+		expectedErr error
+	}{
+		"no error": {},
+		"other error": {
+			err:         errOther,
+			expectedErr: errOther,
+		},
+		"in progress": {
+			err:      errAlreadyExists,
+			status:   "creating",
+			expected: "creating",
+		},
+		"not in progress": {
+			err:         errAlreadyExists,
+			status:      "available",
+			expectedErr: errAlreadyExists,
+		},
+		"not found": {
+			err:         errAlreadyExists,
+			findErr:     &tfresource.EmptyResultError{},
+			expectedErr: errAlreadyExists,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			find := func(context.Context) (string, error) {
+				return testCase.status, testCase.findErr
+			}
+			inProgress := func(v string) bool {
+				return v == "creating"
+			}
+
+			got, err := tfresource.ResumeCreate(context.Background(), testCase.err, alreadyExists, find, inProgress)
+
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("incorrect error. Expected: %v, got: %v", testCase.expectedErr, err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("incorrect result. Expected: %q, got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestCreateToken(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		Name *string
+		Size int
+		Tags []string
+	}
+
+	name := "test"
+	base, err := tfresource.CreateToken(input{Name: &name, Size: 1, Tags: []string{"a", "b"}}, "Tags")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		input    input
+		expected bool
+	}{
+		"same": {
+			input:    input{Name: &name, Size: 1, Tags: []string{"a", "b"}},
+			expected: true,
+		},
+		"ignored field": {
+			input:    input{Name: &name, Size: 1, Tags: []string{"b", "a"}},
+			expected: true,
+		},
+		"different field": {
+			input: input{Name: &name, Size: 2, Tags: []string{"a", "b"}},
+		},
+		"missing field": {
+			input: input{Size: 1, Tags: []string{"a", "b"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfresource.CreateToken(testCase.input, "Tags")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := got == base, testCase.expected; got != expected {
+				t.Errorf("incorrect token match. Expected: %t, got: %t", expected, got)
+			}
+		})
+	}
+}
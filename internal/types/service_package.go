@@ -17,6 +17,22 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourcePermissions represents the IAM actions required by a resource's CRUD handlers.
+type ServicePackageResourcePermissions struct {
+	Create []string
+	Read   []string
+	Update []string
+	Delete []string
+}
+
+// ServicePackageResourceIdentity represents the attributes that, together with the AWS account and Region,
+// uniquely identify an instance of a resource.
+type ServicePackageResourceIdentity struct {
+	Attributes []string // Natural key attributes, in legacy import ID order.
+	Global     bool     // Whether the resource is global, i.e. not Region-scoped.
+	Separator  string   // Separator of the natural key attribute values in the legacy import ID.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -28,9 +44,11 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory     func(context.Context) (resource.ResourceWithConfigure, error)
+	Name        string
+	Tags        *ServicePackageResourceTags
+	Permissions *ServicePackageResourcePermissions
+	Identity    *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -45,8 +63,10 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory     func() *schema.Resource
+	TypeName    string
+	Name        string
+	Tags        *ServicePackageResourceTags
+	Permissions *ServicePackageResourcePermissions
+	Identity    *ServicePackageResourceIdentity
 }
@@ -6,8 +6,10 @@ package verify
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -17,14 +19,20 @@ import (
 
 // SetTagsDiff sets the new plan difference with the result of
 // merging resource tags on to those defined at the provider-level;
-// returns an error if unsuccessful or if the resource tags are identical
+// returns an error if unsuccessful, if the resource tags are identical
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
-// will be indistinguishable when returned from an AWS API.
+// will be indistinguishable when returned from an AWS API, or if
+// the tags violate an enforced Organizations tag policy.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	// Any service or resource type default tags are in Context.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
+
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
@@ -42,6 +50,10 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if err := checkTagPolicy(ctx, diff, meta.(*conns.AWSClient), allTags); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -103,6 +115,52 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// checkTagPolicy validates new or changed tags against the effective Organizations tag policy.
+// In "error" mode violations for resource types for which the policy is enforced are returned as an error.
+// All other violations are logged as CustomizeDiff cannot return warning diagnostics;
+// the provider reports them as warnings when the change is applied.
+func checkTagPolicy(ctx context.Context, diff *schema.ResourceDiff, c *conns.AWSClient, allTags tftags.KeyValueTags) error {
+	mode := c.TagPolicyComplianceMode()
+
+	if mode == "" {
+		return nil
+	}
+
+	if diff.Id() != "" {
+		if o, _ := diff.GetChange("tags_all"); tftags.New(ctx, o).Equal(allTags) {
+			return nil
+		}
+	}
+
+	violations, err := c.TagPolicyViolations(ctx, allTags)
+
+	if err != nil {
+		tflog.Warn(ctx, "unable to check tag policy compliance", map[string]any{
+			"error": err.Error(),
+		})
+
+		return nil
+	}
+
+	var enforced []string
+	for _, v := range violations {
+		if mode == conns.TagPolicyComplianceModeError && v.Enforced {
+			enforced = append(enforced, v.String())
+			continue
+		}
+
+		tflog.Warn(ctx, "tag policy violation", map[string]any{
+			"violation": v.String(),
+		})
+	}
+
+	if len(enforced) > 0 {
+		return fmt.Errorf("tags violate Organizations tag policy: %s", strings.Join(enforced, "; "))
+	}
+
+	return nil
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
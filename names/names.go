@@ -273,6 +273,27 @@ func IsOptInRegion(region string) bool {
 	}
 }
 
+// IsGlobalService returns whether the specified service package's resources and data sources are not Region-scoped.
+// They do not have the per-resource `region` argument.
+func IsGlobalService(servicePackageName string) bool {
+	switch servicePackageName {
+	case "meta",
+		Account,
+		BCMDataExports, Budgets,
+		CE, CUR, CloudFront, CloudFrontKeyValueStore, CostOptimizationHub,
+		GlobalAccelerator,
+		IAM,
+		NetworkManager,
+		Organizations,
+		Route53, Route53Domains, Route53RecoveryControlConfig, Route53RecoveryReadiness,
+		STS, Shield,
+		WAF:
+		return true
+	default:
+		return false
+	}
+}
+
 func PartitionForRegion(region string) string {
 	switch region {
 	case "":
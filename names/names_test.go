@@ -105,6 +105,48 @@ func TestIsOptInRegion(t *testing.T) {
 	}
 }
 
+func TestIsGlobalService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: false,
+		},
+		{
+			name:     "regional",
+			input:    EC2,
+			expected: false,
+		},
+		{
+			name:     "global",
+			input:    IAM,
+			expected: true,
+		},
+		{
+			name:     "meta",
+			input:    "meta",
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IsGlobalService(testCase.input), testCase.expected; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestPartitionForRegion(t *testing.T) {
 	t.Parallel()
 
@@ -16,13 +16,22 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	createOp      string
+	readOp        string
+	updateOp      string
+	deleteOp      string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags, resource.SDKOperations{
+			Create: createOp,
+			Read:   readOp,
+			Update: updateOp,
+			Delete: deleteOp,
+		})
 	},
 }
 
@@ -35,4 +44,8 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&createOp, "create-op", "", "AWS SDK for Go v2 create operation (e.g., CreateWorkspace) to generate the framework resource's schema and models from")
+	resourceCmd.Flags().StringVar(&readOp, "read-op", "", "AWS SDK for Go v2 read operation (e.g., DescribeWorkspace)")
+	resourceCmd.Flags().StringVar(&updateOp, "update-op", "", "AWS SDK for Go v2 update operation (e.g., UpdateWorkspaceAlias), if the resource can be updated in-place")
+	resourceCmd.Flags().StringVar(&deleteOp, "delete-op", "", "AWS SDK for Go v2 delete operation (e.g., DeleteWorkspace)")
 }
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sdkPackage    string
+	humanFriendly string
+	sweepers      bool
+)
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service package",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(name, sdkPackage, humanFriendly, !clearComments, sweepers, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the service package (e.g., qbusiness)")
+	serviceCmd.Flags().StringVarP(&sdkPackage, "sdk-package", "k", "", "name of the AWS SDK for Go v2 service package, if different from the service package name")
+	serviceCmd.Flags().BoolVarP(&sweepers, "sweepers", "w", false, "also create sweepers, which don't compile until the service's resources exist")
+	serviceCmd.Flags().StringVarP(&humanFriendly, "human-friendly", "u", "", "human friendly service name, if skaff doesn't get it right (e.g., Amazon Q Business)")
+	serviceCmd.MarkFlagRequired("name")
+}
@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -15,7 +16,9 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
 )
 
 //go:embed resource.tmpl
@@ -24,6 +27,9 @@ var resourceTmpl string
 //go:embed resourcefw.tmpl
 var resourceFrameworkTmpl string
 
+//go:embed resourcefwsdk.tmpl
+var resourceFrameworkSDKTmpl string
+
 //go:embed resourcetest.tmpl
 var resourceTestTmpl string
 
@@ -45,9 +51,10 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	SDK                  *SDKResource
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool, ops SDKOperations) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -67,6 +74,10 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
+	if ops.IsSet() && (!v2 || !pluginFramework) {
+		return fmt.Errorf("error checking: operations can only be used with AWS SDK for Go v2 and Terraform Plugin Framework resources")
+	}
+
 	snakeName = convert.ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
@@ -105,6 +116,32 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
 	}
+
+	if ops.IsSet() {
+		root := filepath.Join("..", "..", "..")
+
+		sdkPackage, err := goV2Package(servicePackage)
+		if err != nil {
+			return err
+		}
+
+		dir, err := sdk.PackageDir(root, sdkPackage)
+		if err != nil {
+			return err
+		}
+
+		pkg, err := sdk.ReadPackage(dir)
+		if err != nil {
+			return err
+		}
+
+		templateData.SDK, err = newSDKResource(pkg, root, sdkPackage, resName, ops, tags)
+		if err != nil {
+			return fmt.Errorf("error checking: %w", err)
+		}
+
+		tmpl = resourceFrameworkSDKTmpl
+	}
 	f := fmt.Sprintf("%s.go", snakeName)
 	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
@@ -124,6 +161,22 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	return nil
 }
 
+// goV2Package returns the name of the service package's AWS SDK for Go v2 client package.
+func goV2Package(servicePackage string) (string, error) {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return "", fmt.Errorf("error reading service data: %w", err)
+	}
+
+	for _, v := range serviceData {
+		if v.ProviderPackage() == servicePackage {
+			return v.GoV2Package(), nil
+		}
+	}
+
+	return "", fmt.Errorf("error reading service data: service package %q not found", servicePackage)
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
@@ -145,13 +198,18 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	contents := buffer.Bytes()
+
+	// The schema and data models of resources generated from AWS SDK operations are not indented.
+	if templateName == "newres" && td.SDK != nil {
+		contents, err = format.Source(contents)
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("error formatting generated file: %s", err)
+		}
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -0,0 +1,703 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+// SDKOperations are the AWS SDK for Go v2 operations a resource is scaffolded from.
+type SDKOperations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+}
+
+func (ops SDKOperations) IsSet() bool {
+	return ops != SDKOperations{}
+}
+
+// SDKResource is a Terraform Plugin Framework resource whose schema and data models
+// are derived from AWS SDK for Go v2 operations' input and output shapes.
+type SDKResource struct {
+	SDKPackage string
+	Operations SDKOperations
+	// Imports are the non-standard library imports of the generated resource.
+	Imports []string
+	// Attributes and Blocks are the bodies of the resource schema's Attributes and Blocks maps.
+	Attributes string
+	Blocks     string
+	// Model is the body of the resource's data model struct.
+	Model string
+	// NestedModels are the nested objects' data model struct declarations.
+	NestedModels string
+	// UpdateCondition is the expression that is true when an updatable attribute has changed.
+	UpdateCondition string
+	// CreateOutputID is the selector of the resource's ID in the create operation's output, e.g. "Workspace.WorkspaceId".
+	CreateOutputID string
+	// ReadInputID, UpdateInputID and DeleteInputID are the operations' input fields set from the resource's ID.
+	ReadInputID   string
+	UpdateInputID string
+	DeleteInputID string
+	// ReadOutput is the field of the read operation's output containing the resource, or "" if the output is the resource.
+	ReadOutput     string
+	ReadOutputType string
+	ClientToken    bool
+	Tags           bool
+	NotFoundError  string
+}
+
+// sdkFieldsIgnored are input and output fields that are not resource attributes.
+var sdkFieldsIgnored = []string{"ClientToken", "NextToken", "MaxResults", "ResultMetadata", "Tags"}
+
+// sdkInitialisms are capitalizations not listed in names/caps.csv.
+var sdkInitialisms = map[string]string{
+	"Id":  "ID",
+	"Ids": "IDs",
+}
+
+type sdkAttributeKind int
+
+const (
+	sdkKindUnsupported sdkAttributeKind = iota
+	sdkKindBool
+	sdkKindEnum
+	sdkKindFloat64
+	sdkKindInt64
+	sdkKindListOfString
+	sdkKindMapOfString
+	sdkKindNestedObject
+	sdkKindNestedObjectList
+	sdkKindString
+	sdkKindTimestamp
+)
+
+type sdkAttribute struct {
+	Field      sdk.Field
+	Name       string
+	Key        string
+	ModelField string
+	Kind       sdkAttributeKind
+	// Type is the enum or nested object type name in the SDK's types package.
+	Type            string
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+}
+
+type sdkResourceGenerator struct {
+	pkg          *sdk.Package
+	resource     string
+	attrConsts   map[string]string
+	caps         map[string]string
+	imports      map[string]bool
+	models       []string
+	nestedModels strings.Builder
+	stack        []string
+}
+
+// newSDKResource returns the resource generated from the operations.
+// root is the provider repository's root directory, used to read attribute name constants and capitalizations.
+func newSDKResource(pkg *sdk.Package, root, sdkPackage, resource string, ops SDKOperations, tags bool) (*SDKResource, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	operations := make(map[string]*sdk.Operation)
+	for _, name := range []string{ops.Create, ops.Read, ops.Update, ops.Delete} {
+		if name == "" {
+			continue
+		}
+
+		op, ok := pkg.Operations[name]
+		if !ok {
+			return nil, fmt.Errorf("operation %q not found in AWS SDK for Go v2 package %q", name, sdkPackage)
+		}
+
+		operations[name] = op
+	}
+
+	attrConsts, err := readCSVMap(root, "names/attr_constants.csv")
+	if err != nil {
+		return nil, err
+	}
+
+	caps, err := readCSVMap(root, "names/caps.csv")
+	if err != nil {
+		return nil, err
+	}
+
+	g := &sdkResourceGenerator{
+		pkg:        pkg,
+		resource:   resource,
+		attrConsts: attrConsts,
+		caps:       caps,
+		imports:    make(map[string]bool),
+	}
+
+	return g.generate(sdkPackage, ops, operations[ops.Create], operations[ops.Read], operations[ops.Update], operations[ops.Delete], tags), nil
+}
+
+func (g *sdkResourceGenerator) generate(sdkPackage string, ops SDKOperations, createOp, readOp, updateOp, deleteOp *sdk.Operation, tags bool) *SDKResource {
+	r := &SDKResource{
+		SDKPackage: sdkPackage,
+		Operations: ops,
+	}
+
+	readFields := readOp.Output
+	r.ReadOutputType = fmt.Sprintf("%s.%sOutput", sdkPackage, readOp.Name)
+	if v, ok := g.wrappedStruct(readOp.Output); ok {
+		r.ReadOutput = v.Name
+		r.ReadOutputType = "awstypes." + strings.TrimPrefix(v.Type, "*types.")
+		readFields = g.pkg.Types[strings.TrimPrefix(v.Type, "*types.")]
+	}
+
+	var attributes []*sdkAttribute
+	for _, field := range createOp.Input {
+		if slices.Contains(sdkFieldsIgnored, field.Name) {
+			continue
+		}
+
+		attribute := g.newAttribute(field)
+		if field.Required {
+			attribute.Required = true
+		} else {
+			attribute.Optional = true
+		}
+		if updateOp == nil {
+			attribute.RequiresReplace = true
+		} else if _, ok := sdk.FindField(updateOp.Input, field.Name); !ok {
+			attribute.RequiresReplace = true
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	for _, field := range readFields {
+		if slices.Contains(sdkFieldsIgnored, field.Name) {
+			continue
+		}
+
+		if _, ok := sdk.FindField(createOp.Input, field.Name); ok {
+			continue
+		}
+
+		attribute := g.newAttribute(field)
+		attribute.Computed = true
+
+		attributes = append(attributes, attribute)
+	}
+
+	if !slices.ContainsFunc(attributes, func(v *sdkAttribute) bool { return v.Name == "id" }) {
+		attributes = append(attributes, &sdkAttribute{Name: "id", Key: "names.AttrID", ModelField: "ID", Kind: sdkKindString, Computed: true})
+	}
+
+	if tags {
+		r.Tags = true
+		g.imports[`tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`] = true
+		attributes = append(attributes,
+			&sdkAttribute{Name: "tags", Key: "names.AttrTags", ModelField: "Tags", Kind: sdkKindMapOfString, Optional: true},
+			&sdkAttribute{Name: "tags_all", Key: "names.AttrTagsAll", ModelField: "TagsAll", Kind: sdkKindMapOfString, Computed: true},
+		)
+	}
+
+	slices.SortStableFunc(attributes, func(a, b *sdkAttribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var schemaAttributes, schemaBlocks, model strings.Builder
+	var updatable []string
+	for _, attribute := range attributes {
+		if attribute.Kind == sdkKindNestedObject || attribute.Kind == sdkKindNestedObjectList {
+			if attribute.Computed {
+				g.writeNestedAttribute(&schemaAttributes, attribute)
+			} else {
+				g.writeBlock(&schemaBlocks, attribute)
+			}
+		} else {
+			g.writeAttribute(&schemaAttributes, attribute)
+		}
+
+		if attribute.Kind != sdkKindUnsupported {
+			fmt.Fprintf(&model, "%s %s `tfsdk:%q`\n", attribute.ModelField, g.modelType(attribute), attribute.Name)
+		}
+
+		if attribute.Kind != sdkKindUnsupported && !attribute.Computed && !attribute.RequiresReplace && attribute.Name != "tags" {
+			updatable = append(updatable, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", attribute.ModelField))
+		}
+	}
+	fmt.Fprintf(&model, "Timeouts timeouts.Value `tfsdk:\"timeouts\"`\n")
+
+	r.Attributes = schemaAttributes.String()
+	r.Blocks = schemaBlocks.String()
+	r.Model = model.String()
+	r.NestedModels = g.nestedModels.String()
+	r.UpdateCondition = strings.Join(updatable, " ||\n")
+
+	r.CreateOutputID = g.identifierSelector(createOp.Output, true)
+	r.ReadInputID = g.identifierSelector(readOp.Input, false)
+	if updateOp != nil {
+		r.UpdateInputID = g.identifierSelector(updateOp.Input, false)
+	}
+	r.DeleteInputID = g.identifierSelector(deleteOp.Input, false)
+
+	_, r.ClientToken = sdk.FindField(createOp.Input, "ClientToken")
+	if r.ClientToken {
+		g.imports[`"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"`] = true
+	}
+
+	r.NotFoundError = "ResourceNotFoundException"
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException", g.resource + "NotFoundException", "NoSuch" + g.resource + "Exception"} {
+		if _, ok := g.pkg.Types[v]; ok {
+			r.NotFoundError = v
+			break
+		}
+	}
+
+	if r.ClientToken || r.ReadInputID != "" {
+		g.imports[`"github.com/aws/aws-sdk-go-v2/aws"`] = true
+	}
+
+	for _, v := range []string{
+		fmt.Sprintf(`"github.com/aws/aws-sdk-go-v2/service/%s"`, sdkPackage),
+		fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, sdkPackage),
+		`"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`,
+		`"github.com/hashicorp/terraform-plugin-framework/path"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+		`"github.com/hashicorp/terraform-plugin-framework/types"`,
+		`"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/create"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+		`fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+		`"github.com/hashicorp/terraform-provider-aws/names"`,
+	} {
+		g.imports[v] = true
+	}
+
+	for v := range g.imports {
+		r.Imports = append(r.Imports, v)
+	}
+	slices.SortFunc(r.Imports, func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	})
+
+	return r
+}
+
+// wrappedStruct returns the output field containing the resource if the output has a single struct field,
+// for example DescribeWorkspaceOutput.Workspace.
+func (g *sdkResourceGenerator) wrappedStruct(fields []sdk.Field) (sdk.Field, bool) {
+	var structs []sdk.Field
+
+	for _, field := range fields {
+		if slices.Contains(sdkFieldsIgnored, field.Name) {
+			continue
+		}
+
+		if _, ok := g.pkg.Types[strings.TrimPrefix(field.Type, "*types.")]; ok && strings.HasPrefix(field.Type, "*types.") {
+			structs = append(structs, field)
+		}
+	}
+
+	if len(structs) != 1 {
+		return sdk.Field{}, false
+	}
+
+	return structs[0], true
+}
+
+// identifierSelector returns the selector of the field identifying the resource, or "" if there is none.
+// Output fields may be nested in a single struct field.
+func (g *sdkResourceGenerator) identifierSelector(fields []sdk.Field, output bool) string {
+	candidates := []string{g.resource + "Id", g.resource + "Arn", g.resource + "Identifier", g.resource + "Name", "Id", "Arn", "Identifier", "Name"}
+
+	for _, v := range candidates {
+		if field, ok := sdk.FindField(fields, v); ok && field.Type == "*string" {
+			return v
+		}
+	}
+
+	if output {
+		if wrapped, ok := g.wrappedStruct(fields); ok {
+			if v := g.identifierSelector(g.pkg.Types[strings.TrimPrefix(wrapped.Type, "*types.")], false); v != "" {
+				return wrapped.Name + "." + v
+			}
+		}
+
+		return ""
+	}
+
+	// The first required string input.
+	for _, field := range fields {
+		if field.Required && field.Type == "*string" {
+			return field.Name
+		}
+	}
+
+	return ""
+}
+
+func (g *sdkResourceGenerator) newAttribute(field sdk.Field) *sdkAttribute {
+	attribute := &sdkAttribute{
+		Field: field,
+		Name:  convert.ToSnakeCase(field.Name, ""),
+	}
+
+	if v, ok := g.attrConsts[attribute.Name]; ok {
+		attribute.Key = "names.Attr" + v
+		attribute.ModelField = v
+	} else {
+		attribute.Key = fmt.Sprintf("%q", attribute.Name)
+		attribute.ModelField = g.modelFieldName(field.Name)
+	}
+
+	typ := strings.TrimPrefix(field.Type, "*")
+	switch {
+	case typ == "string":
+		attribute.Kind = sdkKindString
+	case typ == "bool":
+		attribute.Kind = sdkKindBool
+	case typ == "int32" || typ == "int64" || typ == "int":
+		attribute.Kind = sdkKindInt64
+	case typ == "float32" || typ == "float64":
+		attribute.Kind = sdkKindFloat64
+	case typ == "time.Time":
+		attribute.Kind = sdkKindTimestamp
+	case typ == "[]string" || g.isEnum(strings.TrimPrefix(typ, "[]")):
+		if strings.HasPrefix(typ, "[]") {
+			attribute.Kind = sdkKindListOfString
+		} else {
+			attribute.Kind = sdkKindEnum
+			attribute.Type = strings.TrimPrefix(typ, "types.")
+		}
+	case typ == "map[string]string":
+		attribute.Kind = sdkKindMapOfString
+	case g.isStruct(typ):
+		attribute.Kind = sdkKindNestedObject
+		attribute.Type = strings.TrimPrefix(typ, "types.")
+	case strings.HasPrefix(typ, "[]") && g.isStruct(strings.TrimPrefix(typ, "[]")):
+		attribute.Kind = sdkKindNestedObjectList
+		attribute.Type = strings.TrimPrefix(typ, "[]types.")
+	}
+
+	if attribute.Type != "" && slices.Contains(g.stack, attribute.Type) {
+		// Recursive types must be handled manually.
+		attribute.Kind = sdkKindUnsupported
+	}
+
+	return attribute
+}
+
+func (g *sdkResourceGenerator) isEnum(typ string) bool {
+	name, ok := strings.CutPrefix(typ, "types.")
+	if !ok {
+		return false
+	}
+
+	_, ok = g.pkg.Enums[name]
+
+	return ok
+}
+
+func (g *sdkResourceGenerator) isStruct(typ string) bool {
+	name, ok := strings.CutPrefix(typ, "types.")
+	if !ok {
+		return false
+	}
+
+	_, ok = g.pkg.Types[name]
+
+	return ok
+}
+
+// modelFieldName returns the data model field name for an SDK field name, e.g. "KmsKeyArn" becomes "KMSKeyARN".
+func (g *sdkResourceGenerator) modelFieldName(name string) string {
+	var sb strings.Builder
+
+	for _, word := range strings.Split(convert.ToSnakeCase(name, ""), "_") {
+		if word == "" {
+			continue
+		}
+
+		word = strings.ToUpper(word[:1]) + word[1:]
+		if v, ok := g.caps[word]; ok {
+			word = v
+		} else if v, ok := sdkInitialisms[word]; ok {
+			word = v
+		}
+
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+func (g *sdkResourceGenerator) modelType(attribute *sdkAttribute) string {
+	switch attribute.Name {
+	case "tags", "tags_all":
+		return "tftags.Map"
+	}
+
+	switch attribute.Kind {
+	case sdkKindBool:
+		return "types.Bool"
+	case sdkKindEnum:
+		return fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", attribute.Type)
+	case sdkKindFloat64:
+		return "types.Float64"
+	case sdkKindInt64:
+		return "types.Int64"
+	case sdkKindListOfString:
+		return "fwtypes.ListValueOf[types.String]"
+	case sdkKindMapOfString:
+		return "fwtypes.MapValueOf[types.String]"
+	case sdkKindNestedObject, sdkKindNestedObjectList:
+		return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", g.nestedModel(attribute.Type))
+	case sdkKindTimestamp:
+		return "timetypes.RFC3339"
+	default:
+		return "types.String"
+	}
+}
+
+// writeAttribute writes a schema attribute.
+func (g *sdkResourceGenerator) writeAttribute(sb *strings.Builder, attribute *sdkAttribute) {
+	switch attribute.Name {
+	case "id":
+		fmt.Fprintf(sb, "%s: framework.IDAttribute(),\n", attribute.Key)
+		return
+	case "arn":
+		if attribute.Computed {
+			fmt.Fprintf(sb, "%s: framework.ARNAttributeComputedOnly(),\n", attribute.Key)
+			return
+		}
+	case "tags":
+		fmt.Fprintf(sb, "%s: tftags.TagsAttribute(),\n", attribute.Key)
+		return
+	case "tags_all":
+		fmt.Fprintf(sb, "%s: tftags.TagsAttributeComputedOnly(),\n", attribute.Key)
+		return
+	}
+
+	var schemaType, customType, elementType, planModifierType string
+	switch attribute.Kind {
+	case sdkKindBool:
+		schemaType, planModifierType = "Bool", "Bool"
+	case sdkKindEnum:
+		schemaType, planModifierType = "String", "String"
+		customType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", attribute.Type)
+	case sdkKindFloat64:
+		schemaType, planModifierType = "Float64", "Float64"
+	case sdkKindInt64:
+		schemaType, planModifierType = "Int64", "Int64"
+	case sdkKindListOfString:
+		schemaType, planModifierType = "List", "List"
+		customType, elementType = "fwtypes.ListOfStringType", "types.StringType"
+	case sdkKindMapOfString:
+		schemaType, planModifierType = "Map", "Map"
+		customType, elementType = "fwtypes.MapOfStringType", "types.StringType"
+	case sdkKindString:
+		schemaType, planModifierType = "String", "String"
+	case sdkKindTimestamp:
+		schemaType, planModifierType = "String", "String"
+		customType = "timetypes.RFC3339Type{}"
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`] = true
+	default:
+		fmt.Fprintf(sb, "// TODO: %s (%s) is not supported by skaff and must be added manually.\n", attribute.Field.Name, attribute.Field.Type)
+		return
+	}
+
+	if strings.HasPrefix(customType, "fwtypes.") {
+		g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = true
+	}
+
+	fmt.Fprintf(sb, "%s: schema.%sAttribute{\n", attribute.Key, schemaType)
+	if customType != "" {
+		fmt.Fprintf(sb, "CustomType: %s,\n", customType)
+	}
+	g.writeAttributeFlags(sb, attribute)
+	if elementType != "" {
+		fmt.Fprintf(sb, "ElementType: %s,\n", elementType)
+	}
+	g.writePlanModifiers(sb, attribute, planModifierType)
+	fmt.Fprintf(sb, "},\n")
+}
+
+// writeNestedAttribute writes a computed nested object attribute.
+// Blocks cannot be computed so computed nested objects are list attributes.
+func (g *sdkResourceGenerator) writeNestedAttribute(sb *strings.Builder, attribute *sdkAttribute) {
+	model := g.nestedModel(attribute.Type)
+
+	g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = true
+
+	fmt.Fprintf(sb, "%s: schema.ListAttribute{\n", attribute.Key)
+	fmt.Fprintf(sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", model)
+	g.writeAttributeFlags(sb, attribute)
+	fmt.Fprintf(sb, "ElementType: types.ObjectType{\nAttrTypes: fwtypes.AttributeTypesMust[%s](ctx),\n},\n", model)
+	g.writePlanModifiers(sb, attribute, "List")
+	fmt.Fprintf(sb, "},\n")
+}
+
+// writeBlock writes a nested object block.
+func (g *sdkResourceGenerator) writeBlock(sb *strings.Builder, attribute *sdkAttribute) {
+	model := g.nestedModel(attribute.Type)
+
+	g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = true
+
+	fmt.Fprintf(sb, "%s: schema.ListNestedBlock{\n", attribute.Key)
+	fmt.Fprintf(sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", model)
+
+	var validators []string
+	if attribute.Required {
+		validators = append(validators, "listvalidator.IsRequired()")
+	}
+	if attribute.Kind == sdkKindNestedObject {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if len(validators) > 0 {
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`] = true
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`] = true
+
+		fmt.Fprintf(sb, "Validators: []validator.List{\n%s,\n},\n", strings.Join(validators, ",\n"))
+	}
+	g.writePlanModifiers(sb, attribute, "List")
+
+	var attributes, blocks strings.Builder
+
+	g.stack = append(g.stack, attribute.Type)
+	for _, field := range g.pkg.Types[attribute.Type] {
+		nested := g.newAttribute(field)
+		if field.Required {
+			nested.Required = true
+		} else {
+			nested.Optional = true
+		}
+
+		if nested.Kind == sdkKindNestedObject || nested.Kind == sdkKindNestedObjectList {
+			g.writeBlock(&blocks, nested)
+		} else {
+			g.writeAttribute(&attributes, nested)
+		}
+	}
+	g.stack = g.stack[:len(g.stack)-1]
+
+	fmt.Fprintf(sb, "NestedObject: schema.NestedBlockObject{\n")
+	if attributes.Len() > 0 {
+		fmt.Fprintf(sb, "Attributes: map[string]schema.Attribute{\n%s},\n", attributes.String())
+	}
+	if blocks.Len() > 0 {
+		fmt.Fprintf(sb, "Blocks: map[string]schema.Block{\n%s},\n", blocks.String())
+	}
+	fmt.Fprintf(sb, "},\n")
+	fmt.Fprintf(sb, "},\n")
+}
+
+func (g *sdkResourceGenerator) writeAttributeFlags(sb *strings.Builder, attribute *sdkAttribute) {
+	if attribute.Required {
+		fmt.Fprintf(sb, "Required: true,\n")
+	}
+	if attribute.Optional {
+		fmt.Fprintf(sb, "Optional: true,\n")
+	}
+	if attribute.Computed {
+		fmt.Fprintf(sb, "Computed: true,\n")
+	}
+}
+
+// writePlanModifiers writes the attribute's plan modifiers:
+// arguments that cannot be updated require replacement and computed attributes use prior state.
+func (g *sdkResourceGenerator) writePlanModifiers(sb *strings.Builder, attribute *sdkAttribute, typ string) {
+	var planModifier string
+	switch {
+	case attribute.RequiresReplace:
+		planModifier = "RequiresReplace"
+	case attribute.Computed:
+		planModifier = "UseStateForUnknown"
+	default:
+		return
+	}
+
+	pkg := strings.ToLower(typ) + "planmodifier"
+
+	g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`] = true
+	g.imports[fmt.Sprintf(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/%s"`, pkg)] = true
+
+	fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n%s.%s(),\n},\n", typ, pkg, planModifier)
+}
+
+// nestedModel returns the name of the data model of a nested object, generating it on first use.
+func (g *sdkResourceGenerator) nestedModel(typ string) string {
+	name := convert.ToLowercasePrefix(typ) + "Data"
+
+	if slices.Contains(g.models, name) {
+		return name
+	}
+	g.models = append(g.models, name)
+
+	var sb strings.Builder
+
+	g.stack = append(g.stack, typ)
+	for _, field := range g.pkg.Types[typ] {
+		attribute := g.newAttribute(field)
+
+		if attribute.Kind == sdkKindUnsupported {
+			fmt.Fprintf(&sb, "// TODO: %s (%s) is not supported by skaff and must be added manually.\n", field.Name, field.Type)
+			continue
+		}
+
+		fmt.Fprintf(&sb, "%s %s `tfsdk:%q`\n", attribute.ModelField, g.modelType(attribute), attribute.Name)
+	}
+	g.stack = g.stack[:len(g.stack)-1]
+
+	fmt.Fprintf(&g.nestedModels, "\ntype %s struct {\n%s}\n", name, sb.String())
+
+	return name
+}
+
+// importPath returns the path of an import spec, which may be named.
+func importPath(spec string) string {
+	if _, after, ok := strings.Cut(spec, " "); ok {
+		return after
+	}
+
+	return spec
+}
+
+// readCSVMap reads a two column CSV file with a header row into a map.
+func readCSVMap(root, name string) (map[string]string, error) {
+	f, err := os.Open(root + "/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", name, err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+
+	m := make(map[string]string, len(records))
+	for i, record := range records {
+		if i == 0 || len(record) < 2 {
+			continue
+		}
+
+		m[record[0]] = record[1]
+	}
+
+	return m, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+func TestModelFieldName(t *testing.T) {
+	g := &sdkResourceGenerator{
+		caps: map[string]string{"Arn": "ARN", "Kms": "KMS"},
+	}
+
+	cases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "no initialisms",
+			Input:    "PrometheusEndpoint",
+			Expected: "PrometheusEndpoint",
+		},
+		{
+			TestName: "caps",
+			Input:    "KmsKeyArn",
+			Expected: "KMSKeyARN",
+		},
+		{
+			TestName: "ID",
+			Input:    "WorkspaceId",
+			Expected: "WorkspaceID",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := g.modelFieldName(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSDKResourceGenerate(t *testing.T) {
+	pkg := &sdk.Package{
+		Operations: map[string]*sdk.Operation{
+			"CreateWidget": {
+				Name: "CreateWidget",
+				Input: []sdk.Field{
+					{Name: "ClientToken", Type: "*string"},
+					{Name: "Configuration", Type: "*types.WidgetConfiguration", Required: true},
+					{Name: "Name", Type: "*string", Required: true},
+					{Name: "Size", Type: "*int32"},
+					{Name: "Tags", Type: "map[string]string"},
+				},
+				Output: []sdk.Field{{Name: "WidgetId", Type: "*string"}},
+			},
+			"GetWidget": {
+				Name:   "GetWidget",
+				Input:  []sdk.Field{{Name: "WidgetId", Type: "*string", Required: true}},
+				Output: []sdk.Field{{Name: "Widget", Type: "*types.Widget"}, {Name: "ResultMetadata", Type: "middleware.Metadata"}},
+			},
+			"UpdateWidget": {
+				Name:  "UpdateWidget",
+				Input: []sdk.Field{{Name: "Size", Type: "*int32"}, {Name: "WidgetId", Type: "*string", Required: true}},
+			},
+			"DeleteWidget": {
+				Name:  "DeleteWidget",
+				Input: []sdk.Field{{Name: "WidgetId", Type: "*string", Required: true}},
+			},
+		},
+		Types: map[string][]sdk.Field{
+			"Widget": {
+				{Name: "Arn", Type: "*string"},
+				{Name: "Configuration", Type: "*types.WidgetConfiguration"},
+				{Name: "CreatedAt", Type: "*time.Time"},
+				{Name: "Name", Type: "*string"},
+				{Name: "Size", Type: "*int32"},
+				{Name: "Status", Type: "types.WidgetStatus"},
+			},
+			"WidgetConfiguration": {
+				{Name: "Colors", Type: "[]string"},
+				{Name: "Mode", Type: "types.WidgetMode", Required: true},
+				{Name: "Parent", Type: "*types.WidgetConfiguration"},
+			},
+			"ResourceNotFoundException": {{Name: "Message", Type: "*string"}},
+		},
+		Enums: map[string][]string{
+			"WidgetMode":   {"FAST", "SLOW"},
+			"WidgetStatus": {"ACTIVE", "CREATING"},
+		},
+	}
+	ops := SDKOperations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+	}
+
+	g := &sdkResourceGenerator{
+		pkg:        pkg,
+		resource:   "Widget",
+		attrConsts: map[string]string{"arn": "ARN", "created_at": "CreatedAt", "name": "Name", "status": "Status"},
+		caps:       map[string]string{"Arn": "ARN"},
+		imports:    make(map[string]bool),
+	}
+
+	got := g.generate("widgets", ops, pkg.Operations["CreateWidget"], pkg.Operations["GetWidget"], pkg.Operations["UpdateWidget"], pkg.Operations["DeleteWidget"], true)
+
+	for _, v := range []struct {
+		Name     string
+		Got      string
+		Expected string
+	}{
+		{"CreateOutputID", got.CreateOutputID, "WidgetId"},
+		{"ReadInputID", got.ReadInputID, "WidgetId"},
+		{"UpdateInputID", got.UpdateInputID, "WidgetId"},
+		{"DeleteInputID", got.DeleteInputID, "WidgetId"},
+		{"ReadOutput", got.ReadOutput, "Widget"},
+		{"ReadOutputType", got.ReadOutputType, "awstypes.Widget"},
+		{"NotFoundError", got.NotFoundError, "ResourceNotFoundException"},
+		{"UpdateCondition", got.UpdateCondition, "!new.Size.Equal(old.Size)"},
+	} {
+		if v.Got != v.Expected {
+			t.Errorf("%s: got %s, expected %s", v.Name, v.Got, v.Expected)
+		}
+	}
+
+	if !got.ClientToken {
+		t.Errorf("ClientToken: got false, expected true")
+	}
+
+	for _, expected := range []string{
+		"names.AttrARN: framework.ARNAttributeComputedOnly(),\n",
+		"names.AttrName: schema.StringAttribute{\nRequired: true,\nPlanModifiers: []planmodifier.String{\nstringplanmodifier.RequiresReplace(),\n},\n},\n",
+		"\"size\": schema.Int64Attribute{\nOptional: true,\n},\n",
+		"names.AttrStatus: schema.StringAttribute{\nCustomType: fwtypes.StringEnumType[awstypes.WidgetStatus](),\nComputed: true,\n",
+		"names.AttrCreatedAt: schema.StringAttribute{\nCustomType: timetypes.RFC3339Type{},\n",
+		"names.AttrTags: tftags.TagsAttribute(),\n",
+	} {
+		if !strings.Contains(got.Attributes, expected) {
+			t.Errorf("Attributes: got %s, expected to contain %s", got.Attributes, expected)
+		}
+	}
+
+	for _, expected := range []string{
+		"\"configuration\": schema.ListNestedBlock{\nCustomType: fwtypes.NewListNestedObjectTypeOf[widgetConfigurationData](ctx),\nValidators: []validator.List{\nlistvalidator.IsRequired(),\nlistvalidator.SizeAtMost(1),\n},\n",
+		"\"colors\": schema.ListAttribute{\nCustomType: fwtypes.ListOfStringType,\nOptional: true,\nElementType: types.StringType,\n},\n",
+		"\"mode\": schema.StringAttribute{\nCustomType: fwtypes.StringEnumType[awstypes.WidgetMode](),\nRequired: true,\n},\n",
+		"// TODO: Parent (*types.WidgetConfiguration) is not supported by skaff and must be added manually.\n",
+	} {
+		if !strings.Contains(got.Blocks, expected) {
+			t.Errorf("Blocks: got %s, expected to contain %s", got.Blocks, expected)
+		}
+	}
+
+	if expected := "Configuration fwtypes.ListNestedObjectValueOf[widgetConfigurationData] `tfsdk:\"configuration\"`\n"; !strings.Contains(got.Model, expected) {
+		t.Errorf("Model: got %s, expected to contain %s", got.Model, expected)
+	}
+
+	if expected := "\ntype widgetConfigurationData struct {\nColors fwtypes.ListValueOf[types.String] `tfsdk:\"colors\"`\nMode fwtypes.StringEnum[awstypes.WidgetMode] `tfsdk:\"mode\"`\n// TODO: Parent (*types.WidgetConfiguration) is not supported by skaff and must be added manually.\n}\n"; got.NestedModels != expected {
+		t.Errorf("NestedModels: got %s, expected %s", got.NestedModels, expected)
+	}
+}
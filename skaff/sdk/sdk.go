@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdk reads the source of AWS SDK for Go v2 service client packages.
+package sdk
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// requiredMemberDoc is the documentation of required input members.
+const requiredMemberDoc = "This member is required."
+
+var (
+	humanFriendlyRegex = regexache.MustCompile(`parameter types for (.+?)\.`)
+	paginatorRegex     = regexache.MustCompile(`^New(\w+)Paginator$`)
+	validationRegex    = regexache.MustCompile(`^addOp(\w+)ValidationMiddleware$`)
+)
+
+// Package describes an AWS SDK for Go v2 service client package.
+type Package struct {
+	ServiceID     string
+	HumanFriendly string
+	// Operations maps operation name to operation.
+	Operations map[string]*Operation
+	// Types maps type name to the struct's fields, from the "types" subpackage.
+	Types map[string][]Field
+	// Enums maps enum type name to the enum's values, from the "types" subpackage.
+	Enums map[string][]string
+}
+
+type Operation struct {
+	Name          string
+	Input         []Field
+	Output        []Field
+	Paginated     bool
+	RequiredInput bool
+}
+
+type Field struct {
+	Name string
+	// Type is the field's type expression, for example "*string", "[]types.Tag" or "map[string]string".
+	Type string
+	// Required is whether the field is documented as required.
+	Required bool
+}
+
+// PackageDir returns the directory containing the source of the AWS SDK for Go v2 service client package.
+// The package must be a dependency of the module in root.
+func PackageDir(root, sdkPackage string) (string, error) {
+	path := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", sdkPackage)
+
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", path)
+	cmd.Dir = root
+	cmd.Stderr = os.Stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating %s (run `go get %s` first): %w", path, path, err)
+	}
+
+	dir := strings.TrimSpace(string(output))
+	if dir == "" {
+		return "", fmt.Errorf("locating %s: module source not downloaded (run `go mod download %s` first)", path, path)
+	}
+
+	return dir, nil
+}
+
+// ReadPackage parses the AWS SDK for Go v2 service client package source in dir.
+func ReadPackage(dir string) (*Package, error) {
+	fset := token.NewFileSet()
+	files, err := parseDir(fset, dir)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", dir, err)
+	}
+
+	pkg := &Package{
+		Operations: make(map[string]*Operation),
+		Types:      make(map[string][]Field),
+		Enums:      make(map[string][]string),
+	}
+	structs := make(map[string][]Field)
+	var paginated, validated []string
+
+	for _, file := range files {
+		if file.Doc != nil {
+			if m := humanFriendlyRegex.FindStringSubmatch(strings.Join(strings.Fields(file.Doc.Text()), " ")); m != nil {
+				pkg.HumanFriendly = m[1]
+			}
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				name := decl.Name.Name
+
+				if decl.Recv != nil {
+					if isClientMethod(decl) {
+						pkg.Operations[name] = &Operation{Name: name}
+					}
+					continue
+				}
+
+				if m := paginatorRegex.FindStringSubmatch(name); m != nil {
+					paginated = append(paginated, m[1])
+				} else if m := validationRegex.FindStringSubmatch(name); m != nil {
+					validated = append(validated, m[1])
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if v, ok := spec.Type.(*ast.StructType); ok {
+							structs[spec.Name.Name] = structFields(v, "")
+						}
+					case *ast.ValueSpec:
+						for i, name := range spec.Names {
+							if name.Name != "ServiceID" || i >= len(spec.Values) {
+								continue
+							}
+
+							if v, ok := spec.Values[i].(*ast.BasicLit); ok && v.Kind == token.STRING {
+								pkg.ServiceID, _ = strconv.Unquote(v.Value)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if pkg.ServiceID == "" {
+		return nil, fmt.Errorf("ServiceID not found in %s", dir)
+	}
+
+	for name, op := range pkg.Operations {
+		op.Input = structs[name+"Input"]
+		op.Output = structs[name+"Output"]
+		op.Paginated = slices.Contains(paginated, name)
+		op.RequiredInput = slices.Contains(validated, name)
+	}
+
+	typeFiles, err := parseDir(fset, filepath.Join(dir, "types"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filepath.Join(dir, "types"), err)
+	}
+
+	values := make(map[string][]string)
+
+	for _, file := range typeFiles {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					switch v := spec.Type.(type) {
+					case *ast.StructType:
+						pkg.Types[spec.Name.Name] = structFields(v, "types")
+					case *ast.Ident:
+						// type Status string
+						if v.Name == "string" {
+							pkg.Enums[spec.Name.Name] = nil
+						}
+					}
+				case *ast.ValueSpec:
+					// StatusActive Status = "ACTIVE"
+					ident, ok := spec.Type.(*ast.Ident)
+					if !ok {
+						continue
+					}
+
+					for _, value := range spec.Values {
+						if v, ok := value.(*ast.BasicLit); ok && v.Kind == token.STRING {
+							v, _ := strconv.Unquote(v.Value)
+							values[ident.Name] = append(values[ident.Name], v)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for name := range pkg.Enums {
+		pkg.Enums[name] = values[name]
+	}
+
+	return pkg, nil
+}
+
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// isClientMethod returns whether the function declaration is an API operation method on *Client.
+func isClientMethod(decl *ast.FuncDecl) bool {
+	if !decl.Name.IsExported() || len(decl.Recv.List) != 1 {
+		return false
+	}
+
+	star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	if ident, ok := star.X.(*ast.Ident); !ok || ident.Name != "Client" {
+		return false
+	}
+
+	// func (c *Client) Operation(ctx context.Context, params *OperationInput, optFns ...func(*Options)) (*OperationOutput, error)
+	params := decl.Type.Params.List
+	if len(params) < 2 {
+		return false
+	}
+
+	return typeString(params[1].Type, "") == "*"+decl.Name.Name+"Input"
+}
+
+// structFields returns the exported fields of a struct.
+// Types declared in the struct's package are qualified with qualifier, if any.
+func structFields(v *ast.StructType, qualifier string) []Field {
+	var fields []Field
+
+	for _, field := range v.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			fields = append(fields, Field{
+				Name:     name.Name,
+				Type:     typeString(field.Type, qualifier),
+				Required: field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMemberDoc),
+			})
+		}
+	}
+
+	return fields
+}
+
+func typeString(expr ast.Expr, qualifier string) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if qualifier != "" && expr.IsExported() {
+			return qualifier + "." + expr.Name
+		}
+		return expr.Name
+	case *ast.StarExpr:
+		return "*" + typeString(expr.X, qualifier)
+	case *ast.ArrayType:
+		return "[]" + typeString(expr.Elt, qualifier)
+	case *ast.MapType:
+		return fmt.Sprintf("map[%s]%s", typeString(expr.Key, qualifier), typeString(expr.Value, qualifier))
+	case *ast.SelectorExpr:
+		return typeString(expr.X, "") + "." + expr.Sel.Name
+	default:
+		return fmt.Sprintf("%T", expr)
+	}
+}
+
+// FindField returns the named field.
+func FindField(fields []Field, name string) (Field, bool) {
+	i := slices.IndexFunc(fields, func(v Field) bool {
+		return v.Name == name
+	})
+
+	if i < 0 {
+		return Field{}, false
+	}
+
+	return fields[i], true
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	namesDataServiceRegex         = regexache.MustCompile(`(?m)^service "([0-9a-z]+)" \{$`)
+	namesDataClientVersionRegex   = regexache.MustCompile(`(?m)^(\s*client_version\s*=\s*)\[1\]$`)
+	namesDataClientRegex          = regexache.MustCompile(`(?m)^  client \{\n(?:.*\n)*?  \}\n\n`)
+	namesDataNotImplementedRegex  = regexache.MustCompile(`(?m)^\s*not_implemented\s*=\s*true\n`)
+	namesDataResourcePrefixRegex  = regexache.MustCompile(`(?m)^  resource_prefix \{$`)
+	namesDataEndpointAPICallRegex = regexache.MustCompile(`(?m)^\s*endpoint_api_call\s*=`)
+)
+
+// namesDataWithService returns the contents of the names data file with the service added.
+// If the service is already present but marked not implemented, it is updated to use the AWS SDK for Go v2.
+func namesDataWithService(filename string, td TemplateData) ([]byte, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := addNamesDataService(string(b), td)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(data), nil
+}
+
+func addNamesDataService(data string, td TemplateData) (string, error) {
+	start, end, ok := namesDataServiceBlock(data, td.ServicePackage)
+
+	if ok {
+		block := data[start:end]
+
+		if !namesDataNotImplementedRegex.MatchString(block) {
+			return data, nil
+		}
+
+		block = namesDataNotImplementedRegex.ReplaceAllString(block, "")
+		block = namesDataClientVersionRegex.ReplaceAllString(block, "${1}[2]")
+		block = namesDataClientRegex.ReplaceAllString(block, "")
+
+		if !namesDataEndpointAPICallRegex.MatchString(block) {
+			endpointInfo := fmt.Sprintf("  endpoint_info {\n    endpoint_api_call        = %q\n  }\n\n", td.EndpointAPICall)
+			if loc := namesDataResourcePrefixRegex.FindStringIndex(block); loc != nil {
+				block = block[:loc[0]] + endpointInfo + block[loc[0]:]
+			}
+		}
+
+		return data[:start] + block + data[end:], nil
+	}
+
+	b, err := executeTemplate("namesdata", namesDataTmpl, td)
+	if err != nil {
+		return "", err
+	}
+
+	// Insert the new service in alphabetical order.
+	offset := len(data)
+	for _, m := range namesDataServiceRegex.FindAllStringSubmatchIndex(data, -1) {
+		if data[m[2]:m[3]] > td.ServicePackage {
+			offset = m[0]
+			break
+		}
+	}
+
+	if offset == len(data) {
+		return strings.TrimRight(data, "\n") + "\n\n" + string(b), nil
+	}
+
+	return data[:offset] + string(b) + "\n" + data[offset:], nil
+}
+
+// namesDataServiceBlock returns the offsets of the named service's block.
+func namesDataServiceBlock(data, name string) (int, int, bool) {
+	loc := regexache.MustCompile(fmt.Sprintf(`(?m)^service "%s" \{$`, regexp.QuoteMeta(name))).FindStringIndex(data)
+	if loc == nil {
+		return 0, 0, false
+	}
+
+	end := strings.Index(data[loc[0]:], "\n}\n")
+	if end < 0 {
+		return 0, 0, false
+	}
+
+	return loc[0], loc[0] + end + len("\n}\n"), true
+}
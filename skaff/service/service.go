@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"bytes"
+	"cmp"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed namesdata.tmpl
+var namesDataTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const namesDataFile = "names/data/names_data.hcl"
+
+var packageNameRegex = regexache.MustCompile(`^[0-9a-z]+$`)
+
+type TemplateData struct {
+	ServicePackage    string
+	SDKPackage        string
+	ServiceID         string
+	ProviderNameUpper string
+	HumanFriendly     string
+	Brand             string
+	EndpointAPICall   string
+	IncludeComments   bool
+	TagsGeneratorArgs string
+	Sweepers          []Sweeper
+}
+
+// Sweeper is a sweeper for a resource type listed by a paginated operation without required parameters.
+type Sweeper struct {
+	Resource      string
+	ResourceLower string
+	ResourceSnake string
+	Function      string
+	Operation     string
+	ItemsField    string
+	IDField       string
+}
+
+// Create scaffolds a service package for the AWS service whose AWS SDK for Go v2 client package is sdkPackage.
+// It must be run from within the provider repository.
+// Sweepers are only scaffolded if requested, as they reference the service's resources and so don't compile until the resources exist.
+func Create(servicePackage, sdkPackage, humanFriendly string, comments, sweepers, force bool) error {
+	if !packageNameRegex.MatchString(servicePackage) {
+		return fmt.Errorf("name should be the all lower case service package name (e.g., qbusiness)")
+	}
+
+	if sdkPackage == "" {
+		sdkPackage = servicePackage
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	root, err := findRoot(wd)
+	if err != nil {
+		return err
+	}
+
+	dir, err := sdk.PackageDir(root, sdkPackage)
+	if err != nil {
+		return err
+	}
+
+	pkg, err := sdk.ReadPackage(dir)
+	if err != nil {
+		return err
+	}
+
+	templateData := newTemplateData(pkg, servicePackage, sdkPackage, humanFriendly)
+	templateData.IncludeComments = comments
+
+	namesDataFilename := filepath.Join(root, namesDataFile)
+	namesData, err := namesDataWithService(namesDataFilename, templateData)
+	if err != nil {
+		return fmt.Errorf("updating names data: %w", err)
+	}
+
+	serviceDir := filepath.Join(root, "internal", "service", servicePackage)
+	files := []templateFile{
+		{name: "generate", filename: filepath.Join(serviceDir, "generate.go"), tmpl: generateTmpl},
+	}
+	if sweepers && len(templateData.Sweepers) > 0 {
+		files = append(files, templateFile{name: "sweep", filename: filepath.Join(serviceDir, "sweep.go"), tmpl: sweepTmpl})
+	}
+
+	// Execute all templates before writing anything so that a failure leaves the repository unchanged.
+	for i, v := range files {
+		if _, err := os.Stat(v.filename); !errors.Is(err, fs.ErrNotExist) && !force {
+			return fmt.Errorf("file (%s) already exists and force is not set", v.filename)
+		}
+
+		b, err := executeTemplate(v.name, v.tmpl, templateData)
+		if err != nil {
+			return fmt.Errorf("executing %s template: %w", v.name, err)
+		}
+
+		files[i].content = b
+	}
+
+	if err := os.MkdirAll(serviceDir, 0755); err != nil {
+		return fmt.Errorf("creating service directory: %w", err)
+	}
+
+	for _, v := range files {
+		if err := os.WriteFile(v.filename, v.content, 0644); err != nil {
+			return fmt.Errorf("error writing to file (%s): %s", v.filename, err)
+		}
+	}
+
+	// The names data is written last as the service package is generated from it.
+	if err := os.WriteFile(namesDataFilename, namesData, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", namesDataFilename, err)
+	}
+
+	fmt.Printf("Scaffolded service package %q. Next, from the repository root run:\n\n", servicePackage)
+	fmt.Printf("\tmake gen\n\tgo mod tidy\n\n")
+	fmt.Printf("then rebuild skaff (make skaff) and create the service's resources with `skaff resource` in %s.\n", serviceDir)
+
+	return nil
+}
+
+// findRoot returns the provider repository's root directory, searching upwards from dir.
+func findRoot(dir string) (string, error) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, namesDataFile)); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("%s not found: run skaff service from within the provider repository", namesDataFile)
+		}
+
+		dir = parent
+	}
+}
+
+func newTemplateData(pkg *sdk.Package, servicePackage, sdkPackage, humanFriendly string) TemplateData {
+	if humanFriendly == "" {
+		humanFriendly = cmp.Or(pkg.HumanFriendly, pkg.ServiceID)
+	}
+
+	brand := "AWS"
+	for _, v := range []string{"Amazon", "AWS"} {
+		if after, ok := strings.CutPrefix(humanFriendly, v+" "); ok {
+			brand, humanFriendly = v, after
+			break
+		}
+	}
+
+	providerNameUpper := strings.NewReplacer(" ", "", "-", "").Replace(pkg.ServiceID)
+	providerNameUpper = strings.ToUpper(providerNameUpper[:1]) + providerNameUpper[1:]
+
+	templateData := TemplateData{
+		ServicePackage:    servicePackage,
+		SDKPackage:        sdkPackage,
+		ServiceID:         pkg.ServiceID,
+		ProviderNameUpper: providerNameUpper,
+		HumanFriendly:     humanFriendly,
+		Brand:             brand,
+		EndpointAPICall:   endpointAPICall(pkg),
+		TagsGeneratorArgs: tagsGeneratorArgs(pkg),
+	}
+
+	listTagsOp, _ := findOperation(pkg, listTagsOps...)
+
+	for _, name := range operationNames(pkg) {
+		op := pkg.Operations[name]
+
+		if !strings.HasPrefix(name, "List") || !op.Paginated || op.RequiredInput || op == listTagsOp {
+			continue
+		}
+
+		if sweeper, ok := newSweeper(pkg, servicePackage, op); ok {
+			templateData.Sweepers = append(templateData.Sweepers, sweeper)
+		}
+	}
+
+	return templateData
+}
+
+// newSweeper returns a sweeper for the resources listed by the operation.
+// The resource name is derived from the type of the listed items, e.g. "WorkspaceSummary" is a "Workspace".
+func newSweeper(pkg *sdk.Package, servicePackage string, op *sdk.Operation) (Sweeper, bool) {
+	i := slices.IndexFunc(op.Output, func(v sdk.Field) bool {
+		return strings.HasPrefix(v.Type, "[]types.")
+	})
+
+	if i < 0 {
+		return Sweeper{}, false
+	}
+
+	items := op.Output[i]
+	itemType := strings.TrimPrefix(items.Type, "[]types.")
+
+	resource := itemType
+	for _, v := range []string{"Summary", "Item", "Info", "Details", "Detail"} {
+		if v, ok := strings.CutSuffix(resource, v); ok && v != "" {
+			resource = v
+			break
+		}
+	}
+
+	var idField string
+	for _, v := range []string{resource + "Id", resource + "Arn", resource + "ARN", "Id", "Arn", "ARN", resource + "Name", "Name"} {
+		if _, ok := sdk.FindField(pkg.Types[itemType], v); ok {
+			idField = v
+			break
+		}
+	}
+
+	// Without the field identifying the resource, the listed items can't be swept.
+	if idField == "" {
+		return Sweeper{}, false
+	}
+
+	return Sweeper{
+		Resource:      resource,
+		ResourceLower: convert.ToLowercasePrefix(resource),
+		ResourceSnake: fmt.Sprintf("%s_%s", servicePackage, convert.ToSnakeCase(resource, "")),
+		Function:      strings.TrimPrefix(op.Name, "List"),
+		Operation:     op.Name,
+		ItemsField:    items.Name,
+		IDField:       idField,
+	}, true
+}
+
+// endpointAPICall returns the name of an operation suitable for testing endpoint configuration:
+// a List operation without required parameters, or any operation without required parameters.
+func endpointAPICall(pkg *sdk.Package) string {
+	names := operationNames(pkg)
+
+	for _, name := range names {
+		if strings.HasPrefix(name, "List") && !pkg.Operations[name].RequiredInput {
+			return name
+		}
+	}
+
+	for _, name := range names {
+		if !pkg.Operations[name].RequiredInput {
+			return name
+		}
+	}
+
+	if len(names) > 0 {
+		return names[0]
+	}
+
+	return ""
+}
+
+func operationNames(pkg *sdk.Package) []string {
+	names := make([]string, 0, len(pkg.Operations))
+
+	for name := range pkg.Operations {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+type templateFile struct {
+	name     string
+	filename string
+	tmpl     string
+	content  []byte
+}
+
+func executeTemplate(templateName, tmpl string, td TemplateData) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
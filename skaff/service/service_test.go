@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+func TestTagsGeneratorArgs(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		Operations map[string]*sdk.Operation
+		Types      map[string][]sdk.Field
+		Expected   string
+	}{
+		{
+			TestName: "no tagging",
+			Operations: map[string]*sdk.Operation{
+				"ListWidgets": {Name: "ListWidgets"},
+			},
+			Expected: "",
+		},
+		{
+			TestName: "map",
+			Operations: map[string]*sdk.Operation{
+				"ListTagsForResource": {
+					Name:   "ListTagsForResource",
+					Input:  []sdk.Field{{Name: "ResourceArn", Type: "*string"}},
+					Output: []sdk.Field{{Name: "Tags", Type: "map[string]string"}},
+				},
+				"TagResource": {
+					Name:  "TagResource",
+					Input: []sdk.Field{{Name: "ResourceArn", Type: "*string"}, {Name: "Tags", Type: "map[string]string"}},
+				},
+				"UntagResource": {
+					Name:  "UntagResource",
+					Input: []sdk.Field{{Name: "ResourceArn", Type: "*string"}, {Name: "TagKeys", Type: "[]string"}},
+				},
+			},
+			Expected: "-AWSSDKVersion=2 -KVTValues -SkipTypesImp -ListTags -ServiceTagsMap -UpdateTags",
+		},
+		{
+			TestName: "slice",
+			Operations: map[string]*sdk.Operation{
+				"ListTagsForResource": {
+					Name:      "ListTagsForResource",
+					Input:     []sdk.Field{{Name: "ResourceARN", Type: "*string"}, {Name: "NextToken", Type: "*string"}},
+					Output:    []sdk.Field{{Name: "Tags", Type: "[]types.Tag"}},
+					Paginated: true,
+				},
+				"TagResource": {
+					Name:  "TagResource",
+					Input: []sdk.Field{{Name: "ResourceARN", Type: "*string"}, {Name: "Tags", Type: "[]types.Tag"}},
+				},
+				"UntagResource": {
+					Name:  "UntagResource",
+					Input: []sdk.Field{{Name: "ResourceARN", Type: "*string"}, {Name: "TagKeys", Type: "[]string"}},
+				},
+			},
+			Types: map[string][]sdk.Field{
+				"Tag": {{Name: "Key", Type: "*string"}, {Name: "Value", Type: "*string"}},
+			},
+			Expected: "-AWSSDKVersion=2 -ListTags -ListTagsInIDElem=ResourceARN -ListTagsOpPaginated -ServiceTagsSlice -TagInIDElem=ResourceARN -UpdateTags",
+		},
+		{
+			TestName: "custom operations",
+			Operations: map[string]*sdk.Operation{
+				"AddTagsToResource": {
+					Name:  "AddTagsToResource",
+					Input: []sdk.Field{{Name: "ResourceId", Type: "*string"}, {Name: "TagList", Type: "[]types.ResourceTag"}},
+				},
+				"RemoveTagsFromResource": {
+					Name:  "RemoveTagsFromResource",
+					Input: []sdk.Field{{Name: "ResourceId", Type: "*string"}, {Name: "Keys", Type: "[]string"}},
+				},
+			},
+			Types: map[string][]sdk.Field{
+				"ResourceTag": {{Name: "TagKey", Type: "*string"}, {Name: "TagValue", Type: "*string"}},
+			},
+			Expected: "-AWSSDKVersion=2 -ServiceTagsSlice -TagType=ResourceTag -TagTypeKeyElem=TagKey -TagTypeValElem=TagValue -TagOp=AddTagsToResource -TagInIDElem=ResourceId -TagInTagsElem=TagList -UntagOp=RemoveTagsFromResource -UntagInTagsElem=Keys -UpdateTags",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := tagsGeneratorArgs(&sdk.Package{Operations: testCase.Operations, Types: testCase.Types})
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestNewTemplateData(t *testing.T) {
+	pkg := &sdk.Package{
+		ServiceID:     "QBusiness",
+		HumanFriendly: "Amazon Q Business",
+		Operations: map[string]*sdk.Operation{
+			"CreateApplication": {Name: "CreateApplication", RequiredInput: true},
+			"ListApplications": {
+				Name:      "ListApplications",
+				Output:    []sdk.Field{{Name: "Applications", Type: "[]types.Application"}, {Name: "NextToken", Type: "*string"}},
+				Paginated: true,
+			},
+			"ListGuardrails": {
+				Name:      "ListGuardrails",
+				Output:    []sdk.Field{{Name: "Guardrails", Type: "[]types.Guardrail"}},
+				Paginated: true,
+			},
+			"ListIndices": {
+				Name:          "ListIndices",
+				Output:        []sdk.Field{{Name: "Indices", Type: "[]types.Index"}},
+				Paginated:     true,
+				RequiredInput: true,
+			},
+			"ListWebExperiences": {
+				Name:      "ListWebExperiences",
+				Output:    []sdk.Field{{Name: "WebExperiences", Type: "[]types.WebExperienceSummary"}},
+				Paginated: true,
+			},
+		},
+		Types: map[string][]sdk.Field{
+			"Application":          {{Name: "ApplicationId", Type: "*string"}, {Name: "DisplayName", Type: "*string"}},
+			"Guardrail":            {{Name: "Description", Type: "*string"}},
+			"WebExperienceSummary": {{Name: "Arn", Type: "*string"}},
+		},
+	}
+
+	got := newTemplateData(pkg, "qbusiness", "qbusiness", "")
+
+	if got, expected := got.ProviderNameUpper, "QBusiness"; got != expected {
+		t.Errorf("ProviderNameUpper: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := got.HumanFriendly, "Q Business"; got != expected {
+		t.Errorf("HumanFriendly: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := got.Brand, "Amazon"; got != expected {
+		t.Errorf("Brand: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := got.EndpointAPICall, "ListApplications"; got != expected {
+		t.Errorf("EndpointAPICall: got %s, expected %s", got, expected)
+	}
+
+	expected := []Sweeper{
+		{
+			Resource:      "Application",
+			ResourceLower: "application",
+			ResourceSnake: "qbusiness_application",
+			Function:      "Applications",
+			Operation:     "ListApplications",
+			ItemsField:    "Applications",
+			IDField:       "ApplicationId",
+		},
+		{
+			Resource:      "WebExperience",
+			ResourceLower: "webExperience",
+			ResourceSnake: "qbusiness_web_experience",
+			Function:      "WebExperiences",
+			Operation:     "ListWebExperiences",
+			ItemsField:    "WebExperiences",
+			IDField:       "Arn",
+		},
+	}
+
+	if len(got.Sweepers) != len(expected) {
+		t.Fatalf("Sweepers: got %v, expected %v", got.Sweepers, expected)
+	}
+
+	for i, v := range got.Sweepers {
+		if v != expected[i] {
+			t.Errorf("Sweepers[%d]: got %v, expected %v", i, v, expected[i])
+		}
+	}
+}
+
+func TestAddNamesDataService(t *testing.T) {
+	const data = `service "accessanalyzer" {
+  brand = "AWS"
+}
+
+service "qldb" {
+
+  sdk {
+    id             = "QLDB"
+    client_version = [1]
+  }
+
+  client {
+    go_v1_client_typename = "QLDB"
+  }
+
+  resource_prefix {
+    correct = "aws_qldb_"
+  }
+
+  brand                    = "AWS"
+  not_implemented          = true
+}
+
+service "sqs" {
+  brand = "AWS"
+}
+`
+
+	td := TemplateData{
+		ServicePackage:    "qbusiness",
+		SDKPackage:        "qbusiness",
+		ServiceID:         "QBusiness",
+		ProviderNameUpper: "QBusiness",
+		HumanFriendly:     "Q Business",
+		Brand:             "Amazon",
+		EndpointAPICall:   "ListApplications",
+	}
+
+	t.Run("new", func(t *testing.T) {
+		got, err := addNamesDataService(data, td)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		start, _, ok := namesDataServiceBlock(got, "qbusiness")
+		if !ok {
+			t.Fatalf("service not added: %s", got)
+		}
+
+		if next := strings.Index(got, `service "qldb"`); start > next {
+			t.Errorf("service not added in alphabetical order: %s", got)
+		}
+
+		for _, v := range []string{`id             = "QBusiness"`, `endpoint_api_call        = "ListApplications"`, `brand                    = "Amazon"`} {
+			if !strings.Contains(got, v) {
+				t.Errorf("expected %q in: %s", v, got)
+			}
+		}
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		td := td
+		td.ServicePackage = "qldb"
+		td.EndpointAPICall = "ListLedgers"
+
+		got, err := addNamesDataService(data, td)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		start, end, _ := namesDataServiceBlock(got, "qldb")
+		block := got[start:end]
+
+		for _, v := range []string{"not_implemented", "client {", "[1]"} {
+			if strings.Contains(block, v) {
+				t.Errorf("unexpected %q in: %s", v, block)
+			}
+		}
+
+		for _, v := range []string{"client_version = [2]", `endpoint_api_call        = "ListLedgers"`} {
+			if !strings.Contains(block, v) {
+				t.Errorf("expected %q in: %s", v, block)
+			}
+		}
+	})
+
+	t.Run("existing", func(t *testing.T) {
+		td := td
+		td.ServicePackage = "sqs"
+
+		got, err := addNamesDataService(data, td)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != data {
+			t.Errorf("existing service modified: %s", got)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+var (
+	listTagsOps = []string{"ListTagsForResource", "ListTags", "ListTagsOfResource"}
+	tagOps      = []string{"TagResource", "AddTagsToResource", "AddTags"}
+	untagOps    = []string{"UntagResource", "RemoveTagsFromResource", "RemoveTags"}
+)
+
+// tagsGeneratorArgs returns the arguments for internal/generate/tags/main.go, or "" if the service does not support tagging.
+// Arguments are only added where the service's tagging operations differ from the generator's defaults.
+func tagsGeneratorArgs(pkg *sdk.Package) string {
+	tagOp, ok := findOperation(pkg, tagOps...)
+	if !ok {
+		return ""
+	}
+
+	untagOp, ok := findOperation(pkg, untagOps...)
+	if !ok {
+		return ""
+	}
+
+	tags, ok := tagsField(tagOp.Input)
+	if !ok {
+		return ""
+	}
+
+	args := []string{"-AWSSDKVersion=2"}
+
+	tagType, isSlice := strings.CutPrefix(tags.Type, "[]types.")
+	if !isSlice {
+		args = append(args, "-KVTValues", "-SkipTypesImp")
+	}
+
+	if listTagsOp, ok := findOperation(pkg, listTagsOps...); ok {
+		args = append(args, "-ListTags")
+
+		if v := listTagsOp.Name; v != "ListTagsForResource" {
+			args = append(args, "-ListTagsOp="+v)
+		}
+
+		if v := idField(listTagsOp.Input); v != "" && v != "ResourceArn" {
+			args = append(args, "-ListTagsInIDElem="+v)
+		}
+
+		if listTagsOp.Paginated {
+			args = append(args, "-ListTagsOpPaginated")
+		}
+
+		if v, ok := tagsField(listTagsOp.Output); ok && v.Name != "Tags" {
+			args = append(args, "-ListTagsOutTagsElem="+v.Name)
+		}
+	}
+
+	if isSlice {
+		args = append(args, "-ServiceTagsSlice")
+
+		if tagType != "Tag" {
+			args = append(args, "-TagType="+tagType)
+		}
+
+		if fields := pkg.Types[tagType]; len(fields) == 2 {
+			if v := fields[0].Name; v != "Key" {
+				args = append(args, "-TagTypeKeyElem="+v)
+			}
+
+			if v := fields[1].Name; v != "Value" {
+				args = append(args, "-TagTypeValElem="+v)
+			}
+		}
+	} else {
+		args = append(args, "-ServiceTagsMap")
+	}
+
+	if v := tagOp.Name; v != "TagResource" {
+		args = append(args, "-TagOp="+v)
+	}
+
+	if v := idField(tagOp.Input); v != "" && v != "ResourceArn" {
+		args = append(args, "-TagInIDElem="+v)
+	}
+
+	if v := tags.Name; v != "Tags" {
+		args = append(args, "-TagInTagsElem="+v)
+	}
+
+	if v := untagOp.Name; v != "UntagResource" {
+		args = append(args, "-UntagOp="+v)
+	}
+
+	for _, field := range untagOp.Input {
+		if field.Type == "[]string" {
+			if v := field.Name; v != "TagKeys" {
+				args = append(args, "-UntagInTagsElem="+v)
+			}
+			break
+		}
+	}
+
+	args = append(args, "-UpdateTags")
+
+	return strings.Join(args, " ")
+}
+
+// findOperation returns the first of the named operations that the service supports.
+func findOperation(pkg *sdk.Package, names ...string) (*sdk.Operation, bool) {
+	for _, name := range names {
+		if op, ok := pkg.Operations[name]; ok {
+			return op, true
+		}
+	}
+
+	return nil, false
+}
+
+// tagsField returns the field containing tags, either a map of strings or a slice of tag structures.
+func tagsField(fields []sdk.Field) (sdk.Field, bool) {
+	for _, field := range fields {
+		if !strings.Contains(field.Name, "Tag") {
+			continue
+		}
+
+		if field.Type == "map[string]string" || strings.HasPrefix(field.Type, "[]types.") {
+			return field, true
+		}
+	}
+
+	return sdk.Field{}, false
+}
+
+// idField returns the name of the field identifying the tagged resource.
+func idField(fields []sdk.Field) string {
+	for _, field := range fields {
+		if field.Type == "*string" {
+			return field.Name
+		}
+	}
+
+	return ""
+}
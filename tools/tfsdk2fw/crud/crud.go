@@ -0,0 +1,984 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package crud migrates Plugin SDK v2 CRUD handlers to Plugin Framework resource methods.
+//
+// Migration is a best-effort source-to-source rewrite of the handler's function body:
+//   - Reads and writes of attributes whose names line up with AWS SDK input or output fields are
+//     replaced with AutoFlex (fwflex.Expand and fwflex.Flatten)
+//   - Plugin SDK v2 idioms (d.Id(), d.SetId(), d.HasChange(), d.Timeout(), sdkdiag.AppendErrorf(), ...)
+//     are replaced with their Plugin Framework equivalents
+//   - Any statement that still references the *schema.ResourceData is emitted as a TODO comment
+package crud
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+// Method is a Plugin Framework resource CRUD method.
+type Method int
+
+const (
+	Create Method = iota
+	Read
+	Update
+	Delete
+)
+
+func (m Method) String() string {
+	switch m {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return fmt.Sprintf("Method(%d)", int(m))
+	}
+}
+
+// Options configures the migration of a Plugin SDK v2 CRUD handler.
+type Options struct {
+	Method      Method
+	Attributes  []string // Top-level attribute and block names.
+	Arguments   []string // Top-level attribute and block names that can be configured (Optional or Required).
+	HasTimeouts bool
+}
+
+// Result is a migrated Plugin SDK v2 CRUD handler.
+type Result struct {
+	Function string            // Name of the Plugin SDK v2 handler function, e.g. resourceInstanceCreate.
+	Body     string            // Migrated statements.
+	Imports  map[string]string // Import specs required by the migrated statements, keyed by package name.
+}
+
+const markerPrefix = "tfsdk2fwMarker"
+
+// Package paths of the Plugin Framework helpers used by migrated code.
+var helperImports = map[string]string{
+	"fmt":    "fmt",
+	"fwdiag": "github.com/hashicorp/terraform-provider-aws/internal/framework/diag",
+	"fwflex": "github.com/hashicorp/terraform-provider-aws/internal/framework/flex",
+}
+
+// MigrateFile migrates the Plugin SDK v2 CRUD handler function named funcName in the Go source file filename.
+func MigrateFile(filename, funcName string, opts Options) (*Result, error) {
+	src, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Migrate(filename, src, funcName, opts)
+}
+
+// Migrate migrates the Plugin SDK v2 CRUD handler function named funcName in the Go source src.
+func Migrate(filename string, src []byte, funcName string, opts Options) (*Result, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var fn *ast.FuncDecl
+	for _, v := range file.Decls {
+		if v, ok := v.(*ast.FuncDecl); ok && v.Recv == nil && v.Name.Name == funcName {
+			fn = v
+			break
+		}
+	}
+
+	if fn == nil {
+		return nil, fmt.Errorf("function %s not found in %s", funcName, filename)
+	}
+
+	if fn.Body == nil {
+		return nil, fmt.Errorf("function %s has no body", funcName)
+	}
+
+	m := &migrator{
+		fset:      fset,
+		src:       src,
+		opts:      opts,
+		data:      "data",
+		flattened: make(map[string]bool),
+	}
+
+	if opts.Method == Update {
+		m.data = "new"
+	}
+
+	// func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	var params []string
+	for _, v := range fn.Type.Params.List {
+		for _, v := range v.Names {
+			params = append(params, v.Name)
+		}
+	}
+
+	if len(params) != 3 {
+		return nil, fmt.Errorf("function %s is not a context-aware CRUD handler", funcName)
+	}
+
+	m.d, m.meta = params[1], params[2]
+
+	fn.Body.List = m.block(fn.Body.List, true)
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, &printer.CommentedNode{Node: fn.Body, Comments: file.Comments}); err != nil {
+		return nil, err
+	}
+
+	body := m.replaceMarkers(buf.String())
+	// Remove the enclosing braces.
+	body = strings.Trim(strings.TrimSuffix(strings.TrimPrefix(body, "{"), "}"), "\n")
+
+	imports, err := m.imports(file, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Function: funcName,
+		Body:     body,
+		Imports:  imports,
+	}, nil
+}
+
+type migrator struct {
+	fset         *token.FileSet
+	src          []byte
+	opts         Options
+	d, meta      string          // Names of the handler's *schema.ResourceData and meta parameters.
+	data         string          // Name of the model variable.
+	input        string          // Name of the current AWS SDK input variable.
+	flattened    map[string]bool // AutoFlex sources already flattened.
+	replacements []string
+}
+
+// block migrates a list of statements.
+func (m *migrator) block(list []ast.Stmt, top bool) []ast.Stmt {
+	var stmts []ast.Stmt
+
+	for i, stmt := range list {
+		stmts = append(stmts, m.stmt(stmt, top && i == len(list)-1)...)
+	}
+
+	return stmts
+}
+
+// stmt migrates a single statement, returning zero or more replacement statements.
+func (m *migrator) stmt(stmt ast.Stmt, last bool) []ast.Stmt {
+	switch stmt := stmt.(type) {
+	case *ast.DeclStmt:
+		// var diags diag.Diagnostics
+		if decl, ok := stmt.Decl.(*ast.GenDecl); ok && decl.Tok == token.VAR && len(decl.Specs) == 1 {
+			if spec, ok := decl.Specs[0].(*ast.ValueSpec); ok && isSelector(spec.Type, "diag", "Diagnostics") {
+				return nil
+			}
+		}
+
+	case *ast.ReturnStmt:
+		return m.returnStmt(stmt, last)
+
+	case *ast.ExprStmt:
+		if call, ok := stmt.X.(*ast.CallExpr); ok {
+			switch {
+			case m.isCall(call, "Set") && len(call.Args) == 2:
+				if m.opts.Method == Read {
+					if src, ok := m.flattenSource(call); ok {
+						if m.flattened[src] {
+							return nil
+						}
+						m.flattened[src] = true
+
+						return []ast.Stmt{m.marker(stmt, fmt.Sprintf(`response.Diagnostics.Append(fwflex.Flatten(ctx, %[1]s, &%[2]s)...)
+if response.Diagnostics.HasError() {
+	return
+}`, src, m.data))}
+					}
+				}
+
+			case m.isCall(call, "SetId") && len(call.Args) == 1:
+				if !isEmptyString(call.Args[0]) {
+					m.rewrite(call)
+
+					return []ast.Stmt{m.marker(stmt, fmt.Sprintf("%s.ID = types.StringValue(%s)", m.data, m.print(call.Args[0])))}
+				}
+			}
+		}
+
+	case *ast.AssignStmt:
+		if m.opts.Method == Create || m.opts.Method == Update {
+			// input.Field = aws.String(d.Get("field").(string))
+			if len(stmt.Lhs) == 1 && len(stmt.Rhs) == 1 && m.isInputField(stmt.Lhs[0]) && m.expandCovers(stmt.Lhs[0].(*ast.SelectorExpr).Sel.Name, stmt.Rhs[0]) {
+				return nil
+			}
+
+			// input := &ec2.RunInstancesInput{...}
+			if len(stmt.Lhs) == 1 && len(stmt.Rhs) == 1 && stmt.Tok == token.DEFINE {
+				if lit, ok := inputLiteral(stmt.Rhs[0]); ok {
+					if ident, ok := stmt.Lhs[0].(*ast.Ident); ok {
+						return m.inputStmt(stmt, ident.Name, lit)
+					}
+				}
+			}
+		}
+
+	case *ast.IfStmt:
+		return m.ifStmt(stmt)
+
+	case *ast.BlockStmt:
+		stmt.List = m.block(stmt.List, false)
+
+		return []ast.Stmt{stmt}
+
+	case *ast.ForStmt:
+		if m.references(stmt.Init) || m.references(stmt.Cond) || m.references(stmt.Post) {
+			return []ast.Stmt{m.todo(stmt)}
+		}
+
+		m.rewrite(stmt.Init)
+		m.rewriteExpr(&stmt.Cond)
+		m.rewrite(stmt.Post)
+		stmt.Body.List = m.block(stmt.Body.List, false)
+
+		return []ast.Stmt{stmt}
+
+	case *ast.RangeStmt:
+		if m.references(stmt.X) {
+			return []ast.Stmt{m.todo(stmt)}
+		}
+
+		m.rewriteExpr(&stmt.X)
+		stmt.Body.List = m.block(stmt.Body.List, false)
+
+		return []ast.Stmt{stmt}
+	}
+
+	return m.leaf(stmt)
+}
+
+// leaf migrates a statement by rewriting its expressions.
+// If the statement still references the *schema.ResourceData it is emitted as a TODO comment.
+func (m *migrator) leaf(stmt ast.Stmt) []ast.Stmt {
+	text := m.source(stmt)
+
+	m.rewrite(stmt)
+
+	if m.references(stmt) {
+		return []ast.Stmt{m.marker(stmt, todo(text))}
+	}
+
+	return []ast.Stmt{stmt}
+}
+
+// inputStmt migrates the declaration of an AWS SDK input variable.
+// Fields covered by AutoFlex are removed and an AutoFlex expand is added after the declaration.
+func (m *migrator) inputStmt(stmt *ast.AssignStmt, input string, lit *ast.CompositeLit) []ast.Stmt {
+	m.input = input
+
+	var todos []string
+	lit.Elts = slices.DeleteFunc(lit.Elts, func(v ast.Expr) bool {
+		if v, ok := v.(*ast.KeyValueExpr); ok {
+			if key, ok := v.Key.(*ast.Ident); ok && m.expandCovers(key.Name, v.Value) {
+				return true
+			}
+		}
+
+		text := m.source(v)
+		m.rewriteExpr(&v)
+
+		if m.references(v) {
+			todos = append(todos, todo(text+","))
+			return true
+		}
+
+		return false
+	})
+
+	stmts := m.leaf(stmt)
+
+	if len(stmts) != 1 || stmts[0] != stmt {
+		return stmts
+	}
+
+	if len(todos) > 0 {
+		stmts = append(stmts, m.marker(stmt, strings.Join(todos, "\n")))
+	}
+
+	return append(stmts, m.marker(stmt, fmt.Sprintf(`response.Diagnostics.Append(fwflex.Expand(ctx, %[1]s, %[2]s)...)
+if response.Diagnostics.HasError() {
+	return
+}`, m.data, input)))
+}
+
+func (m *migrator) returnStmt(stmt *ast.ReturnStmt, last bool) []ast.Stmt {
+	if len(stmt.Results) == 0 || (len(stmt.Results) == 1 && (isIdent(stmt.Results[0], "diags") || isIdent(stmt.Results[0], "nil"))) {
+		if last {
+			return nil
+		}
+
+		return []ast.Stmt{&ast.ReturnStmt{Return: stmt.Return}}
+	}
+
+	if len(stmt.Results) != 1 {
+		return []ast.Stmt{m.todo(stmt)}
+	}
+
+	call, ok := stmt.Results[0].(*ast.CallExpr)
+
+	if !ok {
+		return []ast.Stmt{m.todo(stmt)}
+	}
+
+	text := m.source(stmt)
+
+	switch {
+	// return sdkdiag.AppendErrorf(diags, "creating EC2 Instance: %s", err)
+	case isSelector(call.Fun, "sdkdiag", "AppendErrorf") && len(call.Args) >= 2:
+		m.rewrite(call)
+		return []ast.Stmt{m.marker(stmt, m.addError(call.Args[1], call.Args[2:]))}
+
+	// return diag.Errorf("creating EC2 Instance: %s", err)
+	case isSelector(call.Fun, "diag", "Errorf") && len(call.Args) >= 1:
+		m.rewrite(call)
+		return []ast.Stmt{m.marker(stmt, m.addError(call.Args[0], call.Args[1:]))}
+
+	// return create.AppendDiagError(diags, names.EC2, create.ErrActionCreating, ResNameInstance, name, err)
+	case isSelector(call.Fun, "create", "AppendDiagError") && len(call.Args) == 6:
+		m.rewrite(call)
+		args := make([]string, 0, len(call.Args)-1)
+		for _, v := range call.Args[1:] {
+			args = append(args, m.print(v))
+		}
+		return []ast.Stmt{m.marker(stmt, fmt.Sprintf("response.Diagnostics.AddError(create.ProblemStandardMessage(%s), %s.Error())\n\nreturn", strings.Join(args, ", "), args[len(args)-1]))}
+	}
+
+	// return append(diags, resourceInstanceRead(ctx, d, meta)...)
+	if isIdent(call.Fun, "append") && len(call.Args) == 2 {
+		if v, ok := call.Args[1].(*ast.CallExpr); ok {
+			call = v
+		}
+	}
+	if name, ok := call.Fun.(*ast.Ident); ok && m.references(call) && last {
+		return []ast.Stmt{m.marker(stmt, fmt.Sprintf("// TODO Set values for unknowns, previously done by calling %s.", name.Name))}
+	}
+
+	return []ast.Stmt{m.marker(stmt, todo(text))}
+}
+
+func (m *migrator) ifStmt(stmt *ast.IfStmt) []ast.Stmt {
+	// if !d.IsNewResource() && tfresource.NotFound(err) {
+	//   log.Printf("[WARN] EC2 Instance %s not found, removing from state", d.Id())
+	//   d.SetId("")
+	//   return diags
+	// }
+	if m.opts.Method == Read && stmt.Init == nil && m.isNotFound(stmt) {
+		cond := m.withoutIsNewResource(stmt.Cond)
+		m.rewriteExpr(&cond)
+
+		return []ast.Stmt{m.marker(stmt, fmt.Sprintf(`if %s {
+	response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+	response.State.RemoveResource(ctx)
+
+	return
+}`, m.print(cond)))}
+	}
+
+	// if v, ok := d.GetOk("field"); ok {
+	//   input.Field = aws.String(v.(string))
+	// }
+	if (m.opts.Method == Create || m.opts.Method == Update) && stmt.Else == nil && len(stmt.Body.List) == 1 {
+		if assign, ok := stmt.Body.List[0].(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && m.isInputField(assign.Lhs[0]) {
+			field := assign.Lhs[0].(*ast.SelectorExpr).Sel.Name
+
+			if m.expandCovers(field, stmt.Init) || m.expandCovers(field, stmt.Cond) {
+				return nil
+			}
+		}
+	}
+
+	text := m.source(stmt)
+
+	m.rewrite(stmt.Init)
+	m.rewriteExpr(&stmt.Cond)
+
+	if m.references(stmt.Init) || m.references(stmt.Cond) {
+		return []ast.Stmt{m.marker(stmt, todo(text))}
+	}
+
+	// Parentheses aren't needed around an entire condition.
+	if v, ok := stmt.Cond.(*ast.Ident); ok && strings.HasPrefix(v.Name, "(") && strings.HasSuffix(v.Name, ")") {
+		v.Name = v.Name[1 : len(v.Name)-1]
+	}
+
+	stmt.Body.List = m.block(stmt.Body.List, false)
+
+	switch v := stmt.Else.(type) {
+	case *ast.BlockStmt:
+		v.List = m.block(v.List, false)
+	case *ast.IfStmt:
+		if stmts := m.ifStmt(v); len(stmts) == 1 {
+			stmt.Else = stmts[0]
+		}
+	}
+
+	return []ast.Stmt{stmt}
+}
+
+// addError returns the Plugin Framework code for adding an error diagnostic with the specified format and arguments.
+func (m *migrator) addError(format ast.Expr, args []ast.Expr) string {
+	var summary, detail string
+
+	// "...: %s", err
+	if lit, ok := format.(*ast.BasicLit); ok && lit.Kind == token.STRING && len(args) > 0 {
+		if f, err := strconv.Unquote(lit.Value); err == nil {
+			for _, suffix := range []string{": %s", ": %w"} {
+				if f, ok := strings.CutSuffix(f, suffix); ok {
+					detail = m.print(args[len(args)-1]) + ".Error()"
+					args = args[:len(args)-1]
+					format = &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(f)}
+					break
+				}
+			}
+		}
+	}
+
+	if detail == "" {
+		detail = `""`
+	}
+
+	if len(args) == 0 {
+		summary = m.print(format)
+	} else {
+		v := []string{m.print(format)}
+		for _, arg := range args {
+			v = append(v, m.print(arg))
+		}
+		summary = fmt.Sprintf("fmt.Sprintf(%s)", strings.Join(v, ", "))
+	}
+
+	return fmt.Sprintf("response.Diagnostics.AddError(%s, %s)\n\nreturn", summary, detail)
+}
+
+// rewrite replaces Plugin SDK v2 expressions in node with their Plugin Framework equivalents.
+func (m *migrator) rewrite(node ast.Node) {
+	if node == nil || reflect.ValueOf(node).IsNil() {
+		return
+	}
+
+	walk(reflect.ValueOf(node), m.replace)
+}
+
+// rewriteExpr replaces Plugin SDK v2 expressions in *expr, including *expr itself, with their Plugin Framework equivalents.
+func (m *migrator) rewriteExpr(expr *ast.Expr) {
+	if *expr == nil {
+		return
+	}
+
+	walk(reflect.ValueOf(expr).Elem(), m.replace)
+}
+
+// replace returns the Plugin Framework equivalent of a Plugin SDK v2 expression, or the expression itself.
+func (m *migrator) replace(expr ast.Expr) ast.Expr {
+	switch expr := expr.(type) {
+	case *ast.TypeAssertExpr:
+		// meta.(*conns.AWSClient)
+		if isIdent(expr.X, m.meta) {
+			if star, ok := expr.Type.(*ast.StarExpr); ok && isSelector(star.X, "conns", "AWSClient") {
+				return raw(expr, "r.Meta()")
+			}
+		}
+
+		// d.Get("field").(string)
+		if call, ok := expr.X.(*ast.CallExpr); ok && m.isCall(call, "Get") && len(call.Args) == 1 {
+			if attr, ok := m.attribute(call.Args[0]); ok {
+				field := fmt.Sprintf("%s.%s", m.data, naming.ToCamelCase(attr))
+
+				switch {
+				case isIdent(expr.Type, "string"):
+					return raw(expr, field+".ValueString()")
+				case isIdent(expr.Type, "bool"):
+					return raw(expr, field+".ValueBool()")
+				case isIdent(expr.Type, "int"):
+					return raw(expr, fmt.Sprintf("int(%s.ValueInt64())", field))
+				case isIdent(expr.Type, "float64"):
+					return raw(expr, field+".ValueFloat64()")
+				}
+			}
+		}
+
+	case *ast.CallExpr:
+		switch {
+		// d.Id()
+		case m.isCall(expr, "Id") && len(expr.Args) == 0:
+			return raw(expr, m.data+".ID.ValueString()")
+
+		// d.Timeout(schema.TimeoutCreate)
+		case m.isCall(expr, "Timeout") && len(expr.Args) == 1 && m.opts.HasTimeouts:
+			if sel, ok := expr.Args[0].(*ast.SelectorExpr); ok {
+				if method, ok := strings.CutPrefix(sel.Sel.Name, "Timeout"); ok {
+					return raw(expr, fmt.Sprintf("r.%sTimeout(ctx, %s.Timeouts)", method, m.data))
+				}
+			}
+
+		// d.HasChange("field"), d.HasChanges("field1", "field2")
+		case (m.isCall(expr, "HasChange") || m.isCall(expr, "HasChanges")) && m.opts.Method == Update:
+			var attrs []string
+			for _, v := range expr.Args {
+				attr, ok := m.attribute(v)
+				if !ok {
+					return expr
+				}
+				attrs = append(attrs, attr)
+			}
+			return raw(expr, hasChanges(attrs))
+
+		// d.HasChangesExcept(names.AttrTags, names.AttrTagsAll)
+		case m.isCall(expr, "HasChangesExcept") && m.opts.Method == Update:
+			var except []string
+			for _, v := range expr.Args {
+				attr, ok := m.attribute(v)
+				if !ok {
+					return expr
+				}
+				except = append(except, attr)
+			}
+			var attrs []string
+			for _, v := range m.opts.Arguments {
+				if !slices.Contains(except, v) {
+					attrs = append(attrs, v)
+				}
+			}
+			return raw(expr, hasChanges(attrs))
+		}
+	}
+
+	return expr
+}
+
+// hasChanges returns the Plugin Framework code for checking whether any of the specified attributes have changed.
+func hasChanges(attrs []string) string {
+	if len(attrs) == 0 {
+		return "false"
+	}
+
+	v := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		field := naming.ToCamelCase(attr)
+		v = append(v, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", field))
+	}
+
+	if len(v) == 1 {
+		return v[0]
+	}
+
+	return "(" + strings.Join(v, " || ") + ")"
+}
+
+// flattenSource returns the source of an AutoFlex flatten that covers a d.Set call, e.g.
+// d.Set("instance_type", aws.ToString(instance.InstanceType)) is covered by flattening instance.
+func (m *migrator) flattenSource(call *ast.CallExpr) (string, bool) {
+	attr, ok := m.attribute(call.Args[0])
+
+	if !ok {
+		return "", false
+	}
+
+	value := call.Args[1]
+
+	// aws.ToString(...), aws.StringValue(...), ...
+	if v, ok := value.(*ast.CallExpr); ok && len(v.Args) == 1 {
+		if sel, ok := v.Fun.(*ast.SelectorExpr); ok && isIdent(sel.X, "aws") {
+			value = v.Args[0]
+		}
+	}
+
+	sel, ok := value.(*ast.SelectorExpr)
+
+	if !ok || m.references(sel) || !fieldMatches(attr, sel.Sel.Name) {
+		return "", false
+	}
+
+	return m.print(sel.X), true
+}
+
+// expandCovers returns whether an AutoFlex expand covers the assignment of an AWS SDK input field from node.
+func (m *migrator) expandCovers(field string, node ast.Node) bool {
+	if node == nil || reflect.ValueOf(node).IsNil() {
+		return false
+	}
+
+	covered := false
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && len(call.Args) == 1 {
+			if m.isCall(call, "Get") || m.isCall(call, "GetOk") || m.isCall(call, "HasChange") {
+				if attr, ok := m.attribute(call.Args[0]); ok && fieldMatches(attr, field) {
+					covered = true
+				}
+			}
+		}
+
+		return !covered
+	})
+
+	return covered
+}
+
+// isNotFound returns whether an if statement is the Plugin SDK v2 "resource not found, remove from state" idiom.
+func (m *migrator) isNotFound(stmt *ast.IfStmt) bool {
+	isNewResource, setID := false, false
+
+	ast.Inspect(stmt.Cond, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && m.isCall(call, "IsNewResource") {
+			isNewResource = true
+		}
+		return true
+	})
+
+	for _, v := range stmt.Body.List {
+		if v, ok := v.(*ast.ExprStmt); ok {
+			if call, ok := v.X.(*ast.CallExpr); ok && m.isCall(call, "SetId") && len(call.Args) == 1 && isEmptyString(call.Args[0]) {
+				setID = true
+			}
+		}
+	}
+
+	return isNewResource && setID
+}
+
+// withoutIsNewResource removes any `!d.IsNewResource() &&` operand from a condition.
+func (m *migrator) withoutIsNewResource(cond ast.Expr) ast.Expr {
+	isNotNewResource := func(expr ast.Expr) bool {
+		if v, ok := expr.(*ast.UnaryExpr); ok && v.Op == token.NOT {
+			if call, ok := v.X.(*ast.CallExpr); ok && m.isCall(call, "IsNewResource") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if v, ok := cond.(*ast.BinaryExpr); ok && v.Op == token.LAND {
+		if isNotNewResource(v.X) {
+			return m.withoutIsNewResource(v.Y)
+		}
+		if isNotNewResource(v.Y) {
+			return m.withoutIsNewResource(v.X)
+		}
+	}
+
+	return cond
+}
+
+// attribute returns the top-level attribute name referenced by expr, a string literal or a names.Attr constant.
+func (m *migrator) attribute(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return "", false
+		}
+		if v, err := strconv.Unquote(expr.Value); err == nil && slices.Contains(m.opts.Attributes, v) {
+			return v, true
+		}
+
+	case *ast.SelectorExpr:
+		if !isIdent(expr.X, "names") {
+			return "", false
+		}
+		for _, v := range m.opts.Attributes {
+			if strings.EqualFold("Attr"+naming.ToCamelCase(v), expr.Sel.Name) {
+				return v, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// isCall returns whether call is a call to the specified *schema.ResourceData method.
+func (m *migrator) isCall(call *ast.CallExpr, method string) bool {
+	return isSelector(call.Fun, m.d, method)
+}
+
+// isInputField returns whether expr selects a field of the current AWS SDK input variable.
+func (m *migrator) isInputField(expr ast.Expr) bool {
+	if m.input == "" {
+		return false
+	}
+
+	sel, ok := expr.(*ast.SelectorExpr)
+
+	return ok && isIdent(sel.X, m.input)
+}
+
+// references returns whether node references the *schema.ResourceData.
+func (m *migrator) references(node ast.Node) bool {
+	if node == nil || reflect.ValueOf(node).IsNil() {
+		return false
+	}
+
+	found := false
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if isIdent(n, m.d) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// marker returns a placeholder statement that is replaced by text once the function body has been printed.
+func (m *migrator) marker(stmt ast.Stmt, text string) ast.Stmt {
+	name := fmt.Sprintf("%s%d", markerPrefix, len(m.replacements))
+	m.replacements = append(m.replacements, text)
+
+	return &ast.ExprStmt{X: &ast.Ident{NamePos: stmt.Pos(), Name: name}}
+}
+
+// todo returns a placeholder statement for a statement that must be migrated manually.
+func (m *migrator) todo(stmt ast.Stmt) ast.Stmt {
+	return m.marker(stmt, todo(m.source(stmt)))
+}
+
+// replaceMarkers replaces each placeholder statement's line with its replacement text, preserving indentation.
+func (m *migrator) replaceMarkers(s string) string {
+	lines := strings.Split(s, "\n")
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		n, ok := strings.CutPrefix(trimmed, markerPrefix)
+
+		if !ok {
+			continue
+		}
+
+		index, err := strconv.Atoi(n)
+
+		if err != nil || index >= len(m.replacements) {
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		replacement := strings.Split(m.replacements[index], "\n")
+
+		for j, v := range replacement {
+			if v != "" {
+				replacement[j] = indent + v
+			}
+		}
+
+		lines[i] = strings.Join(replacement, "\n")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// source returns the original source text of a statement, with the statement's indentation removed from continuation lines.
+func (m *migrator) source(node ast.Node) string {
+	start, end := m.fset.Position(node.Pos()), m.fset.Position(node.End())
+	text := string(m.src[start.Offset:end.Offset])
+	indent := "\n" + strings.Repeat("\t", start.Column-1)
+
+	return strings.ReplaceAll(text, indent, "\n")
+}
+
+// print returns the Go source for an expression.
+func (m *migrator) print(expr ast.Expr) string {
+	var buf bytes.Buffer
+
+	if err := printer.Fprint(&buf, m.fset, expr); err != nil {
+		return fmt.Sprintf("/* %s */", err)
+	}
+
+	return buf.String()
+}
+
+// imports returns the import specs required by the migrated function body.
+func (m *migrator) imports(file *ast.File, body string) (map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p\nfunc _() {\n"+body+"\n}", parser.ParseComments)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing migrated function body: %w", err)
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	known := make(map[string]string)
+	for name, path := range helperImports {
+		if name == importName(path) {
+			known[name] = strconv.Quote(path)
+		} else {
+			known[name] = fmt.Sprintf("%s %q", name, path)
+		}
+	}
+	for _, v := range file.Imports {
+		path, err := strconv.Unquote(v.Path.Value)
+
+		if err != nil {
+			continue
+		}
+
+		if v.Name != nil {
+			known[v.Name.Name] = fmt.Sprintf("%s %s", v.Name.Name, v.Path.Value)
+		} else {
+			known[importName(path)] = v.Path.Value
+		}
+	}
+
+	imports := make(map[string]string)
+	for name, spec := range known {
+		if used[name] {
+			imports[name] = spec
+		}
+	}
+
+	return imports, nil
+}
+
+// importName returns the default package name for an import path.
+func importName(path string) string {
+	parts := strings.Split(path, "/")
+	name := parts[len(parts)-1]
+
+	// ".../v2".
+	if len(parts) > 1 && len(name) > 1 && name[0] == 'v' {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			name = parts[len(parts)-2]
+		}
+	}
+
+	return name
+}
+
+// walk calls fn for every expression reachable from v, children first, replacing each expression with fn's result.
+func walk(v reflect.Value, fn func(ast.Expr) ast.Expr) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		// Don't follow the (cyclic) object and scope graphs.
+		switch v.Interface().(type) {
+		case *ast.Object, *ast.Scope:
+			return
+		}
+
+		walk(v.Elem(), fn)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+
+		walk(v.Elem(), fn)
+
+		if v.Type() == exprType && v.CanSet() {
+			v.Set(reflect.ValueOf(fn(v.Interface().(ast.Expr))))
+		}
+
+	case reflect.Slice:
+		for i := range v.Len() {
+			walk(v.Index(i), fn)
+		}
+
+	case reflect.Struct:
+		for i := range v.NumField() {
+			walk(v.Field(i), fn)
+		}
+	}
+}
+
+var exprType = reflect.TypeOf((*ast.Expr)(nil)).Elem()
+
+// raw returns an expression that prints as the specified Go source.
+func raw(expr ast.Expr, src string) ast.Expr {
+	return &ast.Ident{NamePos: expr.Pos(), Name: src}
+}
+
+// todo returns a TODO comment containing the statement text src.
+func todo(src string) string {
+	lines := strings.Split(src, "\n")
+
+	for i, line := range lines {
+		lines[i] = "// " + line
+	}
+
+	return "// TODO Migrate:\n" + strings.Join(lines, "\n")
+}
+
+// fieldMatches returns whether an attribute name matches an AWS SDK field name, ignoring case as AutoFlex does.
+func fieldMatches(attr, field string) bool {
+	return strings.EqualFold(naming.ToCamelCase(attr), field)
+}
+
+// inputLiteral returns the composite literal of an expression of the form &svc.OperationInput{...}.
+func inputLiteral(expr ast.Expr) (*ast.CompositeLit, bool) {
+	unary, ok := expr.(*ast.UnaryExpr)
+
+	if !ok || unary.Op != token.AND {
+		return nil, false
+	}
+
+	lit, ok := unary.X.(*ast.CompositeLit)
+
+	if !ok {
+		return nil, false
+	}
+
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+
+	if !ok || !strings.HasSuffix(sel.Sel.Name, "Input") {
+		return nil, false
+	}
+
+	return lit, true
+}
+
+func isEmptyString(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+
+	return ok && lit.Kind == token.STRING && (lit.Value == `""` || lit.Value == "``")
+}
+
+func isIdent(node ast.Node, name string) bool {
+	ident, ok := node.(*ast.Ident)
+
+	return ok && ident.Name == name
+}
+
+func isSelector(expr ast.Expr, x, sel string) bool {
+	v, ok := expr.(*ast.SelectorExpr)
+
+	return ok && isIdent(v.X, x) && v.Sel.Name == sel
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crud_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/crud"
+)
+
+const testSource = `package example
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/example"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &example.CreateWidgetInput{
+		Name:          aws.String(name),
+		Configuration: expandConfiguration(d.Get("configuration").([]interface{})),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("size"); ok {
+		input.Size = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.CreateWidget(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Example Widget (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.WidgetId))
+
+	if _, err := waitWidgetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Example Widget (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWidgetRead(ctx, d, meta)...)
+}
+
+func resourceWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	widget, err := findWidgetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Example Widget (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Example Widget (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, widget.Arn)
+	// The description.
+	d.Set(names.AttrDescription, aws.ToString(widget.Description))
+	if err := d.Set("configuration", flattenConfiguration(widget.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set(names.AttrName, widget.Name)
+	d.Set("size", widget.Size)
+
+	return diags
+}
+
+func resourceWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &example.UpdateWidgetInput{
+			WidgetId: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("size") {
+			input.Size = aws.Int32(int32(d.Get("size").(int)))
+		}
+
+		_, err := conn.UpdateWidget(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Example Widget (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWidgetRead(ctx, d, meta)...)
+}
+
+func resourceWidgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Example Widget: %s", d.Id())
+	_, err := conn.DeleteWidget(ctx, &example.DeleteWidgetInput{
+		WidgetId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Example Widget (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+`
+
+func TestMigrate(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		Function        string
+		Method          crud.Method
+		ExpectedBody    []string
+		UnexpectedBody  []string
+		ExpectedImports []string
+	}{
+		{
+			TestName: "create",
+			Function: "resourceWidgetCreate",
+			Method:   crud.Create,
+			ExpectedBody: []string{
+				"conn := r.Meta().ExampleClient(ctx)\n",
+				"name := data.Name.ValueString()\n",
+				"response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)\n",
+				"response.Diagnostics.AddError(fmt.Sprintf(\"creating Example Widget (%s)\", name), err.Error())\n\n\t\treturn\n",
+				"data.ID = types.StringValue(aws.ToString(output.WidgetId))\n",
+				"waitWidgetCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))",
+				"// TODO Set values for unknowns, previously done by calling resourceWidgetRead.",
+			},
+			UnexpectedBody: []string{
+				"diags",
+				"d.GetOk",
+				"Configuration:",
+				"input.Description",
+			},
+			ExpectedImports: []string{
+				`"fmt"`,
+				`fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+			},
+		},
+		{
+			TestName: "read",
+			Function: "resourceWidgetRead",
+			Method:   crud.Read,
+			ExpectedBody: []string{
+				"if tfresource.NotFound(err) {\n\t\tresponse.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))\n\t\tresponse.State.RemoveResource(ctx)\n\n\t\treturn\n\t}\n",
+				"response.Diagnostics.Append(fwflex.Flatten(ctx, widget, &data)...)\n",
+				"// TODO Migrate:\n\t// if err := d.Set(\"configuration\", flattenConfiguration(widget.Configuration)); err != nil {\n",
+			},
+			UnexpectedBody: []string{
+				"IsNewResource",
+				"d.Set(names.AttrName",
+				"return diags",
+			},
+			ExpectedImports: []string{
+				`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+				`fwdiag "github.com/hashicorp/terraform-provider-aws/internal/framework/diag"`,
+			},
+		},
+		{
+			TestName: "update",
+			Function: "resourceWidgetUpdate",
+			Method:   crud.Update,
+			ExpectedBody: []string{
+				"if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) || !new.Size.Equal(old.Size) {\n",
+				"WidgetId: aws.String(new.ID.ValueString()),\n",
+				"response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)\n",
+			},
+			UnexpectedBody: []string{
+				"HasChange",
+				"input.Size",
+			},
+		},
+		{
+			TestName: "delete",
+			Function: "resourceWidgetDelete",
+			Method:   crud.Delete,
+			ExpectedBody: []string{
+				"log.Printf(\"[DEBUG] Deleting Example Widget: %s\", data.ID.ValueString())\n",
+				"if errs.IsA[*awstypes.ResourceNotFoundException](err) {\n\t\treturn\n\t}\n",
+			},
+			ExpectedImports: []string{
+				`"log"`,
+				`awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := crud.Migrate("widget.go", []byte(testSource), testCase.Function, crud.Options{
+				Method:      testCase.Method,
+				Attributes:  []string{"arn", "configuration", "description", "name", "size", "tags", "tags_all"},
+				Arguments:   []string{"description", "name", "size", "tags"},
+				HasTimeouts: true,
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, v := range testCase.ExpectedBody {
+				if !strings.Contains(got.Body, v) {
+					t.Errorf("expected body to contain: %s, got: %s", v, got.Body)
+				}
+			}
+
+			for _, v := range testCase.UnexpectedBody {
+				if strings.Contains(got.Body, v) {
+					t.Errorf("expected body not to contain: %s, got: %s", v, got.Body)
+				}
+			}
+
+			specs := make([]string, 0, len(got.Imports))
+			for _, v := range got.Imports {
+				specs = append(specs, v)
+			}
+
+			for _, v := range testCase.ExpectedImports {
+				if !slices.Contains(specs, v) {
+					t.Errorf("expected imports to contain: %s, got: %v", v, got.Imports)
+				}
+			}
+		})
+	}
+}
+
+func TestMigrateFunctionNotFound(t *testing.T) {
+	_, err := crud.Migrate("widget.go", []byte(testSource), "resourceGadgetCreate", crud.Options{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	_ "embed"
 	"flag"
@@ -11,24 +12,32 @@ import (
 	"io"
 	"os"
 	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/crud"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
 
 var (
-	dataSourceType = flag.String("data-source", "", "Data Source type")
-	resourceType   = flag.String("resource", "", "Resource type")
+	dataSourceType     = flag.String("data-source", "", "Data Source type")
+	resourceType       = flag.String("resource", "", "Resource type")
+	sdkProviderVersion = flag.String("sdk-provider-version", "", "Provider version used by the state-compatibility acceptance test to apply with the Plugin SDK v2 resource (default: the last release in CHANGELOG.md)")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-sdk-provider-version <version>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -58,7 +67,8 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
+	ctx := context.Background()
+	p, err := provider.New(ctx)
 
 	if err != nil {
 		g.Fatalf(err.Error())
@@ -76,7 +86,7 @@ func main() {
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		resource, ok := sdkResource(ctx, p, v)
 
 		if !ok {
 			g.Fatalf("resource type %s not found", v)
@@ -84,7 +94,21 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
+		migrator.ProviderNameUpper = providerNameUpper(packageName)
+		migrator.SDKProviderVersion = *sdkProviderVersion
+
+		if migrator.SDKProviderVersion == "" {
+			version, err := lastReleasedVersion()
+
+			if err != nil {
+				g.Warnf("determining last released provider version: %s", err)
+				version = "TODO"
+			}
+
+			migrator.SDKProviderVersion = version
+		}
 	}
 
 	if err := migrator.migrate(outputFilename); err != nil {
@@ -92,17 +116,95 @@ func main() {
 	}
 }
 
+// sdkResource returns the Plugin SDK v2 resource for the specified resource type.
+// The resource is created by its service package's factory so that its CRUD handlers are not wrapped by the provider's interceptors.
+func sdkResource(ctx context.Context, p *schema.Provider, typeName string) (*schema.Resource, bool) {
+	if meta, ok := p.Meta().(*conns.AWSClient); ok {
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				if v.TypeName == typeName {
+					return v.Factory(), true
+				}
+			}
+		}
+	}
+
+	resource, ok := p.ResourcesMap[typeName]
+
+	return resource, ok
+}
+
+// providerNameUpper returns the upper-case provider name of the service with the specified provider package name.
+func providerNameUpper(packageName string) string {
+	if services, err := data.ReadAllServiceData(); err == nil {
+		for _, v := range services {
+			if v.ProviderPackage() == packageName {
+				return v.ProviderNameUpper()
+			}
+		}
+	}
+
+	return naming.ToCamelCase(packageName)
+}
+
+var releaseHeaderRegexp = regexp.MustCompile(`^## (\d+\.\d+\.\d+) \(`)
+
+// lastReleasedVersion returns the last released provider version from CHANGELOG.md,
+// searching upwards from the working directory.
+func lastReleasedVersion() (string, error) {
+	dir, err := os.Getwd()
+
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		f, err := os.Open(filepath.Join(dir, "CHANGELOG.md"))
+
+		if err == nil {
+			defer f.Close()
+
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				line := scanner.Text()
+
+				if m := releaseHeaderRegexp.FindStringSubmatch(line); m != nil && !strings.Contains(line, "Unreleased") {
+					return m[1], nil
+				}
+			}
+
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+
+			return "", fmt.Errorf("no release found in %s", f.Name())
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("CHANGELOG.md not found")
+		}
+
+		dir = parent
+	}
+}
+
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	Generator          *common.Generator
+	IsDataSource       bool
+	Name               string
+	PackageName        string
+	ProviderNameUpper  string
+	Resource           *schema.Resource
+	SDKProviderVersion string
+	Template           string
+	TestTemplate       string
+	TFTypeName         string
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, the CRUD handlers and state upgraders are migrated and a state-compatibility acceptance test
+// is generated into the corresponding _test.go file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -126,17 +228,36 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_test.go"
+	m.infof("generating state-compatibility acceptance test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("test", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbNestedStructs := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		Generator:          m.Generator,
+		IsDataSource:       m.IsDataSource,
+		NestedStructWriter: &sbNestedStructs,
+		SchemaWriter:       &sbSchema,
+		StructWriter:       &sbStruct,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -157,12 +278,40 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
+		NestedStructs:                sbNestedStructs.String(),
 		PackageName:                  m.PackageName,
+		ProviderNameUpper:            m.ProviderNameUpper,
+		SDKProviderVersion:           m.SDKProviderVersion,
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		imports := make(map[string]string)
+
+		m.migrateHandlers(templateData, imports)
+		m.migrateStateUpgraders(templateData, imports)
+
+		// Import any packages required by migrated code that the template doesn't import.
+		templateImports := templateData.importNames()
+		for name, spec := range imports {
+			if slices.Contains(templateImports, name) {
+				continue
+			}
+
+			// Standard library import paths have no dot in their first element.
+			importPath := spec[strings.Index(spec, `"`)+1:]
+			if first, _, _ := strings.Cut(importPath, "/"); strings.Contains(first, ".") {
+				templateData.Imports = append(templateData.Imports, spec)
+			} else {
+				templateData.StdlibImports = append(templateData.StdlibImports, spec)
+			}
+		}
+		slices.Sort(templateData.Imports)
+		slices.Sort(templateData.StdlibImports)
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -182,6 +331,132 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// migrateHandlers migrates the Plugin SDK v2 resource's CRUD handlers.
+// Any handler that can't be migrated is generated as a skeleton method.
+func (m *migrator) migrateHandlers(templateData *templateData, imports map[string]string) {
+	var attributes, arguments []string
+	for name, property := range m.Resource.Schema {
+		if name == "id" {
+			continue
+		}
+
+		attributes = append(attributes, name)
+
+		if property.Optional || property.Required {
+			arguments = append(arguments, name)
+		}
+	}
+	slices.Sort(attributes)
+	slices.Sort(arguments)
+
+	handlers := []struct {
+		method   crud.Method
+		handlers []any
+		result   **crud.Result
+	}{
+		{crud.Create, []any{m.Resource.CreateWithoutTimeout, m.Resource.CreateContext}, &templateData.Create},
+		{crud.Read, []any{m.Resource.ReadWithoutTimeout, m.Resource.ReadContext}, &templateData.Read},
+		{crud.Update, []any{m.Resource.UpdateWithoutTimeout, m.Resource.UpdateContext}, &templateData.Update},
+		{crud.Delete, []any{m.Resource.DeleteWithoutTimeout, m.Resource.DeleteContext}, &templateData.Delete},
+	}
+
+	for _, v := range handlers {
+		var filename, funcName string
+		var ok bool
+		for _, handler := range v.handlers {
+			if filename, _, funcName, ok = funcSource(handler); ok {
+				break
+			}
+		}
+
+		if !ok {
+			continue
+		}
+
+		result, err := crud.MigrateFile(filename, funcName, crud.Options{
+			Method:      v.method,
+			Attributes:  attributes,
+			Arguments:   arguments,
+			HasTimeouts: templateData.HasTimeouts,
+		})
+
+		if err != nil {
+			m.Generator.Warnf("migrating %s handler %s: %s", v.method, funcName, err)
+			continue
+		}
+
+		*v.result = result
+
+		for name, spec := range result.Imports {
+			imports[name] = spec
+		}
+	}
+}
+
+// migrateStateUpgraders migrates the Plugin SDK v2 resource's state upgraders.
+// The Plugin Framework upgrades state from each prior version directly to the current version, so each
+// generated state upgrader runs all the Plugin SDK v2 state upgrade functions from its version onwards.
+func (m *migrator) migrateStateUpgraders(templateData *templateData, imports map[string]string) {
+	upgraders := slices.Clone(m.Resource.StateUpgraders)
+	slices.SortFunc(upgraders, func(a, b schema.StateUpgrader) int {
+		return a.Version - b.Version
+	})
+
+	var funcs []stateUpgradeFunc
+	for _, v := range upgraders {
+		f := stateUpgradeFunc{Version: v.Version}
+
+		if _, pkgPath, funcName, ok := funcSource(v.Upgrade); ok {
+			f.Name = funcName
+
+			if pkgName := path.Base(pkgPath); pkgName != m.PackageName {
+				f.Name = pkgName + "." + funcName
+				imports[pkgName] = fmt.Sprintf("%q", pkgPath)
+			}
+		} else {
+			m.Generator.Warnf("state upgrade function for schema version %d can't be migrated", v.Version)
+		}
+
+		funcs = append(funcs, f)
+	}
+
+	for i, v := range upgraders {
+		templateData.StateUpgraders = append(templateData.StateUpgraders, stateUpgrader{
+			Version:   v.Version,
+			Functions: funcs[i:],
+		})
+	}
+}
+
+// funcSource returns the source file, package path and name of a package-level function.
+func funcSource(f any) (string, string, string, bool) {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return "", "", "", false
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+
+	if fn == nil {
+		return "", "", "", false
+	}
+
+	filename, _ := fn.FileLine(fn.Entry())
+
+	// e.g. "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceInstanceCreate".
+	fullName := fn.Name()
+	i := strings.LastIndex(fullName, "/") + 1
+	pkgName, funcName, ok := strings.Cut(fullName[i:], ".")
+
+	// Closures, e.g. "resourceInstance.func1", can't be migrated.
+	if !ok || strings.Contains(funcName, ".") {
+		return "", "", "", false
+	}
+
+	return filename, fullName[:i] + pkgName, funcName, true
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
@@ -200,9 +475,11 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	NestedStructWriter            io.Writer
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
+	nestedModelNames              map[string]bool
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -263,8 +540,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -286,10 +561,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,9 +569,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -321,6 +591,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -328,6 +599,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -354,9 +626,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +634,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +642,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +655,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +663,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +680,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +691,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +702,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -614,10 +870,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.nestedModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(modelName, path, v.Schema)
 
 			if err != nil {
 				return err
@@ -637,10 +898,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.nestedModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(modelName, path, v.Schema)
 
 			if err != nil {
 				return err
@@ -712,6 +978,43 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedModel generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the nested object's model struct to the emitter's NestedStructWriter.
+func (e *emitter) emitNestedModel(modelName string, path []string, schema map[string]*schema.Schema) error {
+	structWriter := e.StructWriter
+	sbStruct := strings.Builder{}
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	fprintf(e.NestedStructWriter, "\ntype %s struct {\n%s}\n", modelName, sbStruct.String())
+
+	return nil
+}
+
+// nestedModelName returns the name of the model struct for the Plugin SDK Block at the specified path.
+// The Block's name is used unless another Block has the same name, in which case the full path is used.
+func (e *emitter) nestedModelName(path []string) string {
+	name := naming.ToLowerCamelCase(path[len(path)-1]) + "Model"
+
+	if e.nestedModelNames[name] {
+		name = naming.ToLowerCamelCase(strings.Join(path, "_")) + "Model"
+	}
+
+	if e.nestedModelNames == nil {
+		e.nestedModelNames = make(map[string]bool)
+	}
+	e.nestedModelNames[name] = true
+
+	return name
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -883,6 +1186,10 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	Create                        *crud.Result // Migrated CRUD handlers. nil if not migrated.
+	Read                          *crud.Result
+	Update                        *crud.Result
+	Delete                        *crud.Result
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -895,16 +1202,74 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
-	Name                          string // e.g. Instance
+	Imports                       []string // Import specs required by migrated code.
+	Name                          string   // e.g. Instance
+	NestedStructs                 string
 	PackageName                   string // e.g. ec2
+	ProviderNameUpper             string // e.g. EC2
 	ProviderPlanModifierPackages  []string
 	Schema                        string
+	SDKProviderVersion            string // e.g. 5.59.0
+	StateUpgraders                []stateUpgrader
+	StdlibImports                 []string // Standard library import specs required by migrated code.
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
 
+// importNames returns the names of the packages imported by the resource template.
+func (td *templateData) importNames() []string {
+	names := []string{"context", "framework", "resource", "schema", "types"}
+
+	if td.HasTimeouts {
+		names = append(names, "time", "timeouts")
+	}
+	if td.ImportFrameworkAttr {
+		names = append(names, "attr")
+	}
+	if td.EmitResourceImportState {
+		names = append(names, "path")
+	}
+	if len(td.FrameworkPlanModifierPackages) > 0 || len(td.ProviderPlanModifierPackages) > 0 {
+		names = append(names, "planmodifier")
+	}
+	if len(td.FrameworkValidatorsPackages) > 0 {
+		names = append(names, "validator")
+	}
+	names = append(names, td.FrameworkPlanModifierPackages...)
+	names = append(names, td.FrameworkValidatorsPackages...)
+	for _, v := range td.ProviderPlanModifierPackages {
+		names = append(names, "fw"+v)
+	}
+	if td.ImportProviderFrameworkTypes {
+		names = append(names, "fwtypes")
+	}
+	if td.Delete == nil {
+		names = append(names, "tflog")
+	}
+	if len(td.StateUpgraders) > 0 {
+		names = append(names, "json", "tfprotov6")
+	}
+
+	return names
+}
+
+// stateUpgrader upgrades state from a prior schema version to the current version.
+type stateUpgrader struct {
+	Version   int
+	Functions []stateUpgradeFunc
+}
+
+// stateUpgradeFunc is a Plugin SDK v2 state upgrade function.
+type stateUpgradeFunc struct {
+	Version int
+	Name    string // Empty if the function can't be migrated.
+}
+
 //go:embed datasource.tmpl
 var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed resource_test.tmpl
+var resourceTestImpl string
@@ -45,6 +45,25 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+// A leading initialism is lowercased in its entirety, e.g. "arn_config" becomes "arnConfig".
+func ToLowerCamelCase(s string) string {
+	b := []byte(ToCamelCase(s))
+
+	for i := range b {
+		if !isCapitalLetter(b[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(b) && isLowercaseLetter(b[i+1]) {
+			break
+		}
+
+		b[i] = toLowercaseLetter(b[i])
+	}
+
+	return string(b)
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }
@@ -62,3 +81,9 @@ func toCapitalLetter(ch byte) byte {
 	ch -= 'a'
 	return ch
 }
+
+func toLowercaseLetter(ch byte) byte {
+	ch += 'a'
+	ch -= 'A'
+	return ch
+}
@@ -67,3 +67,52 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToLowerCamelCase(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "description",
+			ExpectedValue: "description",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "health_check_config",
+			ExpectedValue: "healthCheckConfig",
+		},
+		{
+			TestName:      "ID",
+			Value:         "id",
+			ExpectedValue: "id",
+		},
+		{
+			TestName:      "something ARN",
+			Value:         "something_arn",
+			ExpectedValue: "somethingARN",
+		},
+		{
+			TestName:      "leading initialism",
+			Value:         "ARNConfig",
+			ExpectedValue: "arnConfig",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToLowerCamelCase(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}